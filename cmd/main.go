@@ -4,7 +4,12 @@ import (
 	"github.com/YehyeokBang/Simple-SNS/config"
 	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
 	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/export"
+	"github.com/YehyeokBang/Simple-SNS/pkg/mail"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
 	"github.com/YehyeokBang/Simple-SNS/pkg/server"
+	"github.com/YehyeokBang/Simple-SNS/pkg/storage"
+	"github.com/YehyeokBang/Simple-SNS/pkg/timeline"
 )
 
 func main() {
@@ -12,9 +17,24 @@ func main() {
 
 	db := db.MustNewGormDB(cfg)
 
-	jwt := auth.NewJWT(cfg.JWTSecret)
+	keys := auth.MustNewKeySet(cfg.JWTKeysFile, cfg.JWTSecret)
 
-	server := server.NewServer(db, jwt)
+	jwt := auth.NewJWT(keys, auth.NewGormDenylist(db), auth.NewGormSessionStore(db))
+
+	timeline := timeline.MustNewStrategy(cfg.TimelineStrategy)
+
+	broker := pubsub.NewMemoryBroker()
+
+	mailer := mail.MustNewMailer(cfg)
+
+	exporter := export.MustNewExporter(db, cfg.ExportDir)
+	exporter.Start()
+
+	storage := storage.MustNewStorage(cfg)
+
+	server := server.NewServer(db, jwt, timeline, broker, mailer, exporter, storage)
+
+	go server.MustRunJWKSServer(cfg.JWKSAddr)
 
 	server.MustRunGRPCServer()
 }
@@ -7,6 +7,9 @@ import (
 	"github.com/joho/godotenv"
 )
 
+// HS256 키는 최소 256bit 여야 한다
+const minJWTSecretLength = 32
+
 type Config struct {
 	DBUser     string
 	DBPassword string
@@ -14,6 +17,29 @@ type Config struct {
 	DBPort     string
 	DBName     string
 	JWTSecret  string
+
+	// 설정하면 JWT_SECRET 대신 이 파일에 적힌 RS256/EdDSA 키로 서명한다
+	JWTKeysFile string
+	JWKSAddr    string
+
+	TimelineStrategy string
+
+	SMTPAddr     string
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	MailDir      string
+
+	// 개인 데이터 내보내기 파일을 저장할 디렉터리, 비어 있으면 임시 디렉터리를 쓴다
+	ExportDir string
+
+	// 첨부 파일 저장소, S3_ENDPOINT 가 있으면 S3 호환 스토리지를, 없으면 MEDIA_DIR 을 쓴다
+	MediaDir    string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
 }
 
 func MustNewConfig() *Config {
@@ -36,6 +62,20 @@ func MustNewConfig() *Config {
 		}
 	}
 
+	if os.Getenv("JWT_KEYS_FILE") == "" && len(os.Getenv("JWT_SECRET")) < minJWTSecretLength {
+		log.Fatalf("environment variable JWT_SECRET must be at least %d characters when JWT_KEYS_FILE is not set", minJWTSecretLength)
+	}
+
+	jwksAddr := os.Getenv("JWKS_ADDR")
+	if jwksAddr == "" {
+		jwksAddr = ":8080"
+	}
+
+	mediaDir := os.Getenv("MEDIA_DIR")
+	if mediaDir == "" {
+		mediaDir = "media"
+	}
+
 	return &Config{
 		DBUser:     os.Getenv("MYSQL_USER"),
 		DBPassword: os.Getenv("MYSQL_PASSWORD"),
@@ -43,5 +83,25 @@ func MustNewConfig() *Config {
 		DBPort:     os.Getenv("MYSQL_PORT"),
 		DBName:     os.Getenv("MYSQL_DATABASE"),
 		JWTSecret:  os.Getenv("JWT_SECRET"),
+
+		JWTKeysFile: os.Getenv("JWT_KEYS_FILE"),
+		JWKSAddr:    jwksAddr,
+
+		TimelineStrategy: os.Getenv("TIMELINE_STRATEGY"),
+
+		SMTPAddr:     os.Getenv("SMTP_ADDR"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		MailFrom:     os.Getenv("MAIL_FROM"),
+		MailDir:      os.Getenv("MAIL_DIR"),
+
+		ExportDir: os.Getenv("EXPORT_DIR"),
+
+		MediaDir:    mediaDir,
+		S3Endpoint:  os.Getenv("S3_ENDPOINT"),
+		S3Region:    os.Getenv("S3_REGION"),
+		S3Bucket:    os.Getenv("S3_BUCKET"),
+		S3AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("S3_SECRET_KEY"),
 	}
 }
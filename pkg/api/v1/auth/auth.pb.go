@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: pkg/api/v1/auth/auth.proto
+
+package auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RPC 의 인증 방식, 옵션이 없는 RPC 는 토큰이 있어야 호출할 수 있다
+//
+//	rpc SignUp(SignUpRequest) returns (SignUpResponse) {
+//	  option (v1.auth.auth).public = true;
+//	}
+type MethodAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 토큰 없이 호출할 수 있고, 토큰을 보내도 검사하지 않는다
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// 토큰 없이 호출할 수 있지만, 토큰을 보내면 검사한 뒤 사용자 ID 를 넘긴다
+	Optional bool `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+}
+
+func (x *MethodAuth) Reset() {
+	*x = MethodAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auth_auth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodAuth) ProtoMessage() {}
+
+func (x *MethodAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auth_auth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodAuth.ProtoReflect.Descriptor instead.
+func (*MethodAuth) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auth_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MethodAuth) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *MethodAuth) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+var file_pkg_api_v1_auth_auth_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodAuth)(nil),
+		Field:         50000,
+		Name:          "v1.auth.auth",
+		Tag:           "bytes,50000,opt,name=auth",
+		Filename:      "pkg/api/v1/auth/auth.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional v1.auth.MethodAuth auth = 50000;
+	E_Auth = &file_pkg_api_v1_auth_auth_proto_extTypes[0]
+)
+
+var File_pkg_api_v1_auth_auth_proto protoreflect.FileDescriptor
+
+var file_pkg_api_v1_auth_auth_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x76, 0x31,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x3a, 0x49, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x59, 0x65, 0x68, 0x79, 0x65, 0x6f, 0x6b, 0x42, 0x61, 0x6e, 0x67, 0x2f, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x53, 0x4e, 0x53, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pkg_api_v1_auth_auth_proto_rawDescOnce sync.Once
+	file_pkg_api_v1_auth_auth_proto_rawDescData = file_pkg_api_v1_auth_auth_proto_rawDesc
+)
+
+func file_pkg_api_v1_auth_auth_proto_rawDescGZIP() []byte {
+	file_pkg_api_v1_auth_auth_proto_rawDescOnce.Do(func() {
+		file_pkg_api_v1_auth_auth_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_auth_auth_proto_rawDescData)
+	})
+	return file_pkg_api_v1_auth_auth_proto_rawDescData
+}
+
+var file_pkg_api_v1_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_api_v1_auth_auth_proto_goTypes = []interface{}{
+	(*MethodAuth)(nil),                 // 0: v1.auth.MethodAuth
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_pkg_api_v1_auth_auth_proto_depIdxs = []int32{
+	1, // 0: v1.auth.auth:extendee -> google.protobuf.MethodOptions
+	0, // 1: v1.auth.auth:type_name -> v1.auth.MethodAuth
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_v1_auth_auth_proto_init() }
+func file_pkg_api_v1_auth_auth_proto_init() {
+	if File_pkg_api_v1_auth_auth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_api_v1_auth_auth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MethodAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_api_v1_auth_auth_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_api_v1_auth_auth_proto_goTypes,
+		DependencyIndexes: file_pkg_api_v1_auth_auth_proto_depIdxs,
+		MessageInfos:      file_pkg_api_v1_auth_auth_proto_msgTypes,
+		ExtensionInfos:    file_pkg_api_v1_auth_auth_proto_extTypes,
+	}.Build()
+	File_pkg_api_v1_auth_auth_proto = out.File
+	file_pkg_api_v1_auth_auth_proto_rawDesc = nil
+	file_pkg_api_v1_auth_auth_proto_goTypes = nil
+	file_pkg_api_v1_auth_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.1
+// source: pkg/api/v1/media/media.proto
+
+package media
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MediaServiceClient is the client API for MediaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MediaServiceClient interface {
+	// 첫 메시지로 metadata 를, 이후 chunk 들을 보낸다
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (MediaService_UploadMediaClient, error)
+	// 게시글에 첨부된 파일은 그 게시글을 볼 수 있는 사용자가, 첨부 전의 파일은 올린 사용자만 내려받을 수 있다
+	DownloadMedia(ctx context.Context, in *DownloadMediaRequest, opts ...grpc.CallOption) (MediaService_DownloadMediaClient, error)
+}
+
+type mediaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMediaServiceClient(cc grpc.ClientConnInterface) MediaServiceClient {
+	return &mediaServiceClient{cc}
+}
+
+func (c *mediaServiceClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (MediaService_UploadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MediaService_ServiceDesc.Streams[0], "/v1.media.MediaService/UploadMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mediaServiceUploadMediaClient{stream}
+	return x, nil
+}
+
+type MediaService_UploadMediaClient interface {
+	Send(*UploadMediaRequest) error
+	CloseAndRecv() (*UploadMediaResponse, error)
+	grpc.ClientStream
+}
+
+type mediaServiceUploadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *mediaServiceUploadMediaClient) Send(m *UploadMediaRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mediaServiceUploadMediaClient) CloseAndRecv() (*UploadMediaResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadMediaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mediaServiceClient) DownloadMedia(ctx context.Context, in *DownloadMediaRequest, opts ...grpc.CallOption) (MediaService_DownloadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MediaService_ServiceDesc.Streams[1], "/v1.media.MediaService/DownloadMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mediaServiceDownloadMediaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MediaService_DownloadMediaClient interface {
+	Recv() (*MediaChunk, error)
+	grpc.ClientStream
+}
+
+type mediaServiceDownloadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *mediaServiceDownloadMediaClient) Recv() (*MediaChunk, error) {
+	m := new(MediaChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MediaServiceServer is the server API for MediaService service.
+// All implementations must embed UnimplementedMediaServiceServer
+// for forward compatibility
+type MediaServiceServer interface {
+	// 첫 메시지로 metadata 를, 이후 chunk 들을 보낸다
+	UploadMedia(MediaService_UploadMediaServer) error
+	// 게시글에 첨부된 파일은 그 게시글을 볼 수 있는 사용자가, 첨부 전의 파일은 올린 사용자만 내려받을 수 있다
+	DownloadMedia(*DownloadMediaRequest, MediaService_DownloadMediaServer) error
+	mustEmbedUnimplementedMediaServiceServer()
+}
+
+// UnimplementedMediaServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMediaServiceServer struct {
+}
+
+func (UnimplementedMediaServiceServer) UploadMedia(MediaService_UploadMediaServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedMediaServiceServer) DownloadMedia(*DownloadMediaRequest, MediaService_DownloadMediaServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadMedia not implemented")
+}
+func (UnimplementedMediaServiceServer) mustEmbedUnimplementedMediaServiceServer() {}
+
+// UnsafeMediaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MediaServiceServer will
+// result in compilation errors.
+type UnsafeMediaServiceServer interface {
+	mustEmbedUnimplementedMediaServiceServer()
+}
+
+func RegisterMediaServiceServer(s grpc.ServiceRegistrar, srv MediaServiceServer) {
+	s.RegisterService(&MediaService_ServiceDesc, srv)
+}
+
+func _MediaService_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MediaServiceServer).UploadMedia(&mediaServiceUploadMediaServer{stream})
+}
+
+type MediaService_UploadMediaServer interface {
+	SendAndClose(*UploadMediaResponse) error
+	Recv() (*UploadMediaRequest, error)
+	grpc.ServerStream
+}
+
+type mediaServiceUploadMediaServer struct {
+	grpc.ServerStream
+}
+
+func (x *mediaServiceUploadMediaServer) SendAndClose(m *UploadMediaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mediaServiceUploadMediaServer) Recv() (*UploadMediaRequest, error) {
+	m := new(UploadMediaRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MediaService_DownloadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadMediaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MediaServiceServer).DownloadMedia(m, &mediaServiceDownloadMediaServer{stream})
+}
+
+type MediaService_DownloadMediaServer interface {
+	Send(*MediaChunk) error
+	grpc.ServerStream
+}
+
+type mediaServiceDownloadMediaServer struct {
+	grpc.ServerStream
+}
+
+func (x *mediaServiceDownloadMediaServer) Send(m *MediaChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// MediaService_ServiceDesc is the grpc.ServiceDesc for MediaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MediaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.media.MediaService",
+	HandlerType: (*MediaServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _MediaService_UploadMedia_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadMedia",
+			Handler:       _MediaService_DownloadMedia_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/v1/media/media.proto",
+}
@@ -24,11 +24,18 @@ const _ = grpc.SupportPackageIsVersion7
 type PostServiceClient interface {
 	WritePost(ctx context.Context, in *WritePostRequest, opts ...grpc.CallOption) (*WritePostResponse, error)
 	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	GetHomeTimeline(ctx context.Context, in *GetHomeTimelineRequest, opts ...grpc.CallOption) (*GetHomeTimelineResponse, error)
 	SearchPostsByTitle(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
 	SearchPostsByWriter(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	ListUserPosts(ctx context.Context, in *ListUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	SearchPostsByTag(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error)
 	GetPostById(ctx context.Context, in *GetPostByIdRequest, opts ...grpc.CallOption) (*GetPostByIdResponse, error)
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
+	UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error)
+	ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error)
 }
 
 type postServiceClient struct {
@@ -57,6 +64,15 @@ func (c *postServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, o
 	return out, nil
 }
 
+func (c *postServiceClient) GetHomeTimeline(ctx context.Context, in *GetHomeTimelineRequest, opts ...grpc.CallOption) (*GetHomeTimelineResponse, error) {
+	out := new(GetHomeTimelineResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/GetHomeTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) SearchPostsByTitle(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
 	out := new(GetPostsResponse)
 	err := c.cc.Invoke(ctx, "/v1.post.PostService/SearchPostsByTitle", in, out, opts...)
@@ -75,6 +91,33 @@ func (c *postServiceClient) SearchPostsByWriter(ctx context.Context, in *SearchP
 	return out, nil
 }
 
+func (c *postServiceClient) ListUserPosts(ctx context.Context, in *ListUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/ListUserPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SearchPostsByTag(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/SearchPostsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error) {
+	out := new(ListTrendingTagsResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/ListTrendingTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) GetPostById(ctx context.Context, in *GetPostByIdRequest, opts ...grpc.CallOption) (*GetPostByIdResponse, error) {
 	out := new(GetPostByIdResponse)
 	err := c.cc.Invoke(ctx, "/v1.post.PostService/GetPostById", in, out, opts...)
@@ -102,17 +145,51 @@ func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
+	out := new(LikePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/LikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error) {
+	out := new(UnlikePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/UnlikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error) {
+	out := new(ListPostLikersResponse)
+	err := c.cc.Invoke(ctx, "/v1.post.PostService/ListPostLikers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility
 type PostServiceServer interface {
 	WritePost(context.Context, *WritePostRequest) (*WritePostResponse, error)
 	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
+	GetHomeTimeline(context.Context, *GetHomeTimelineRequest) (*GetHomeTimelineResponse, error)
 	SearchPostsByTitle(context.Context, *SearchPostsRequest) (*GetPostsResponse, error)
 	SearchPostsByWriter(context.Context, *SearchPostsRequest) (*GetPostsResponse, error)
+	ListUserPosts(context.Context, *ListUserPostsRequest) (*GetPostsResponse, error)
+	SearchPostsByTag(context.Context, *SearchPostsRequest) (*GetPostsResponse, error)
+	ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error)
 	GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error)
 	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
 	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
+	UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error)
+	ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -126,12 +203,24 @@ func (UnimplementedPostServiceServer) WritePost(context.Context, *WritePostReque
 func (UnimplementedPostServiceServer) GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
 }
+func (UnimplementedPostServiceServer) GetHomeTimeline(context.Context, *GetHomeTimelineRequest) (*GetHomeTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeTimeline not implemented")
+}
 func (UnimplementedPostServiceServer) SearchPostsByTitle(context.Context, *SearchPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPostsByTitle not implemented")
 }
 func (UnimplementedPostServiceServer) SearchPostsByWriter(context.Context, *SearchPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPostsByWriter not implemented")
 }
+func (UnimplementedPostServiceServer) ListUserPosts(context.Context, *ListUserPostsRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserPosts not implemented")
+}
+func (UnimplementedPostServiceServer) SearchPostsByTag(context.Context, *SearchPostsRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPostsByTag not implemented")
+}
+func (UnimplementedPostServiceServer) ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingTags not implemented")
+}
 func (UnimplementedPostServiceServer) GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
 }
@@ -141,6 +230,15 @@ func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostReq
 func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedPostServiceServer) UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
+}
+func (UnimplementedPostServiceServer) ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostLikers not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 
 // UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -190,6 +288,24 @@ func _PostService_GetPosts_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetHomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetHomeTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/GetHomeTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetHomeTimeline(ctx, req.(*GetHomeTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_SearchPostsByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchPostsRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +342,60 @@ func _PostService_SearchPostsByWriter_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ListUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/ListUserPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListUserPosts(ctx, req.(*ListUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SearchPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SearchPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/SearchPostsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SearchPostsByTag(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/ListTrendingTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListTrendingTags(ctx, req.(*ListTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPostByIdRequest)
 	if err := dec(in); err != nil {
@@ -280,6 +450,60 @@ func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/LikePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).LikePost(ctx, req.(*LikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnlikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnlikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/UnlikePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnlikePost(ctx, req.(*UnlikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.post.PostService/ListPostLikers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostLikers(ctx, req.(*ListPostLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostService_ServiceDesc is the grpc.ServiceDesc for PostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +519,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosts",
 			Handler:    _PostService_GetPosts_Handler,
 		},
+		{
+			MethodName: "GetHomeTimeline",
+			Handler:    _PostService_GetHomeTimeline_Handler,
+		},
 		{
 			MethodName: "SearchPostsByTitle",
 			Handler:    _PostService_SearchPostsByTitle_Handler,
@@ -303,6 +531,18 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchPostsByWriter",
 			Handler:    _PostService_SearchPostsByWriter_Handler,
 		},
+		{
+			MethodName: "ListUserPosts",
+			Handler:    _PostService_ListUserPosts_Handler,
+		},
+		{
+			MethodName: "SearchPostsByTag",
+			Handler:    _PostService_SearchPostsByTag_Handler,
+		},
+		{
+			MethodName: "ListTrendingTags",
+			Handler:    _PostService_ListTrendingTags_Handler,
+		},
 		{
 			MethodName: "GetPostById",
 			Handler:    _PostService_GetPostById_Handler,
@@ -315,6 +555,18 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "LikePost",
+			Handler:    _PostService_LikePost_Handler,
+		},
+		{
+			MethodName: "UnlikePost",
+			Handler:    _PostService_UnlikePost_Handler,
+		},
+		{
+			MethodName: "ListPostLikers",
+			Handler:    _PostService_ListPostLikers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/v1/post/post.proto",
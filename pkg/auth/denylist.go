@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"log"
+	"time"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Denylist interface {
+	Revoke(jti string, expiresAt time.Time) error
+	// 조회에 실패하면 에러를 반환한다, 호출하는 쪽은 토큰을 거부해야 한다
+	IsRevoked(jti string) (bool, error)
+}
+
+// GormDenylist 는 폐기된 jti 를 revoked_tokens 테이블에 저장한다
+type GormDenylist struct {
+	DB *gorm.DB
+}
+
+func NewGormDenylist(db *gorm.DB) *GormDenylist {
+	return &GormDenylist{
+		DB: db,
+	}
+}
+
+func (d *GormDenylist) Revoke(jti string, expiresAt time.Time) error {
+	// 이미 만료된 토큰은 ValidateToken 에서 걸러지므로 더 이상 보관할 필요가 없다
+	if err := d.DB.Where("expires_at < ?", time.Now()).Delete(&db.RevokedToken{}).Error; err != nil {
+		log.Printf("denylist: failed to remove expired tokens: %v", err)
+	}
+
+	return d.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&db.RevokedToken{
+		JTI:       jti,
+		ExpiresAt: expiresAt,
+	}).Error
+}
+
+func (d *GormDenylist) IsRevoked(jti string) (bool, error) {
+	var count int64
+	if err := d.DB.Model(&db.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
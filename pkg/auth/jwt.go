@@ -8,47 +8,80 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	AccessTokenTTL  = 30 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
 type JWT struct {
-	SecretKey string
+	Keys     *KeySet
+	Denylist Denylist
+	Sessions SessionStore
 }
 
 type ContextKey string
 
-const UserIDKey ContextKey = "user_id"
+const (
+	UserIDKey    ContextKey = "user_id"
+	TokenIDKey   ContextKey = "token_id"
+	SessionIDKey ContextKey = "session_id"
+	RoleKey      ContextKey = "role"
+)
 
-func NewJWT(secretKey string) *JWT {
+func NewJWT(keys *KeySet, denylist Denylist, sessions SessionStore) *JWT {
 	return &JWT{
-		SecretKey: secretKey,
+		Keys:     keys,
+		Denylist: denylist,
+		Sessions: sessions,
 	}
 }
 
-func (j *JWT) CreateToken(userID string) (string, error) {
-	claim := j.generateUserClaims(userID)
+// role 은 토큰이 만료될 때까지 유지되므로 역할이 바뀌면 세션을 끊어 다시 발급받게 해야 한다
+func (j *JWT) CreateToken(userID, sessionID, role string) (string, error) {
+	claim, err := j.generateUserClaims(userID, sessionID, role)
+	if err != nil {
+		return "", err
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
+	key, err := j.Keys.SigningKey(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Method, claim)
+	token.Header["kid"] = key.ID
 
-	return token.SignedString([]byte(j.SecretKey))
+	return token.SignedString(key.signKey)
 }
 
-func (j *JWT) generateUserClaims(userID string) jwt.MapClaims {
+func (j *JWT) generateUserClaims(userID, sessionID, role string) (jwt.MapClaims, error) {
+	jti, err := RandomToken()
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	claim := jwt.MapClaims{
-		"sub": userID,
-		"iat": now.Unix(),
-		"exp": now.Add(time.Hour * 24).Unix(),
+		"sub":  userID,
+		"jti":  jti,
+		"sid":  sessionID,
+		"role": role,
+		"iat":  now.Unix(),
+		"exp":  now.Add(AccessTokenTTL).Unix(),
 	}
 
-	return claim
+	return claim, nil
 }
 
 func (j *JWT) ValidateToken(token string) (jwt.MapClaims, error) {
 	parsedToken, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
-		_, ok := t.Method.(*jwt.SigningMethodHMAC)
-		if !ok {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := j.Keys.VerificationKey(kid, time.Now())
+		if !ok || t.Method.Alg() != key.Method.Alg() {
 			return nil, status.Error(codes.Unauthenticated, "access denied: invalid token")
 		}
 
-		return []byte(j.SecretKey), nil
+		return key.verifyKey, nil
 	})
 
 	if err != nil {
@@ -60,5 +93,29 @@ func (j *JWT) ValidateToken(token string) (jwt.MapClaims, error) {
 		return nil, status.Error(codes.Unauthenticated, "access denied: invalid token")
 	}
 
+	// 폐기 여부를 확인할 수 없으면 폐기된 것으로 보고 거부한다
+	if jti, ok := mapClaims["jti"].(string); ok && j.Denylist != nil {
+		revoked, err := j.Denylist.IsRevoked(jti)
+		if err != nil || revoked {
+			return nil, status.Error(codes.Unauthenticated, "access denied: token is revoked")
+		}
+	}
+
+	if j.Sessions != nil {
+		sessionID, ok := mapClaims["sid"].(string)
+		if !ok || !j.Sessions.Touch(sessionID) {
+			return nil, status.Error(codes.Unauthenticated, "access denied: session is revoked")
+		}
+	}
+
 	return mapClaims, nil
 }
+
+// 만료되기 전까지 jti 로 access token 을 거부한다
+func (j *JWT) RevokeToken(jti string) error {
+	if j.Denylist == nil || jti == "" {
+		return nil
+	}
+
+	return j.Denylist.Revoke(jti, time.Now().Add(AccessTokenTTL))
+}
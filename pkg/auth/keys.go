@@ -0,0 +1,254 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 서명 키 하나, kid 로 구분한다
+type Key struct {
+	ID        string
+	Method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	NotBefore time.Time // 이 시각부터 서명에 사용한다
+	NotAfter  time.Time // 이 시각부터 서명에 사용하지 않는다, 다음 키가 있으면 다음 키의 NotBefore 를 넘지 않는다
+}
+
+// KeySet 은 서명 키를 NotBefore 순서로 들고 있다
+// 새 키의 NotBefore 가 지나면 자동으로 서명 키가 바뀌고
+// 이전 키는 그 키로 서명한 토큰이 모두 만료될 때까지 검증에 계속 사용한다
+type KeySet struct {
+	keys []Key
+}
+
+type keyFile struct {
+	Keys []struct {
+		ID             string    `json:"kid"`
+		Algorithm      string    `json:"algorithm"`
+		PrivateKeyFile string    `json:"private_key_file"`
+		NotBefore      time.Time `json:"not_before"`
+		NotAfter       time.Time `json:"not_after"`
+	} `json:"keys"`
+}
+
+// keysFile 이 있으면 RS256/EdDSA 키를 읽고, 없으면 secret 으로 HS256 키 하나를 만든다
+func MustNewKeySet(keysFile, secret string) *KeySet {
+	if keysFile == "" {
+		return &KeySet{
+			keys: []Key{{
+				ID:        "default",
+				Method:    jwt.SigningMethodHS256,
+				signKey:   []byte(secret),
+				verifyKey: []byte(secret),
+			}},
+		}
+	}
+
+	keySet, err := LoadKeySet(keysFile)
+	if err != nil {
+		log.Fatalf("failed to load jwt keys: %v", err)
+	}
+
+	return keySet
+}
+
+// private_key_file 은 keysFile 기준 상대 경로로 적을 수 있다
+func LoadKeySet(keysFile string) (*KeySet, error) {
+	data, err := os.ReadFile(keysFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var file keyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	if len(file.Keys) == 0 {
+		return nil, errors.New("no keys are configured")
+	}
+
+	keySet := &KeySet{}
+	seen := make(map[string]bool)
+	for _, entry := range file.Keys {
+		if entry.ID == "" || seen[entry.ID] {
+			return nil, fmt.Errorf("kid %q is empty or duplicated", entry.ID)
+		}
+		seen[entry.ID] = true
+
+		path := entry.PrivateKeyFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(keysFile), path)
+		}
+
+		key, err := loadPrivateKey(entry.Algorithm, path)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", entry.ID, err)
+		}
+
+		key.ID = entry.ID
+		key.NotBefore = entry.NotBefore
+		key.NotAfter = entry.NotAfter
+		keySet.keys = append(keySet.keys, key)
+	}
+
+	sort.Slice(keySet.keys, func(i, j int) bool {
+		return keySet.keys[i].NotBefore.Before(keySet.keys[j].NotBefore)
+	})
+
+	// not_after 를 적지 않아도 다음 키로 바뀐 뒤에는 서명에서 빠지고
+	// AccessTokenTTL 이 더 지나면 검증과 JWKS 에서도 빠진다
+	for i := 0; i < len(keySet.keys)-1; i++ {
+		next := keySet.keys[i+1].NotBefore
+		if key := &keySet.keys[i]; key.NotAfter.IsZero() || key.NotAfter.After(next) {
+			key.NotAfter = next
+		}
+	}
+
+	return keySet, nil
+}
+
+func loadPrivateKey(algorithm, path string) (Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Key{}, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("private key is not PEM encoded")
+	}
+
+	var privateKey interface{}
+	if block.Type == "RSA PRIVATE KEY" {
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	} else {
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch algorithm {
+	case "RS256":
+		rsaKey, ok := privateKey.(*rsa.PrivateKey)
+		if !ok {
+			return Key{}, errors.New("RS256 requires an RSA private key")
+		}
+		return Key{Method: jwt.SigningMethodRS256, signKey: rsaKey, verifyKey: &rsaKey.PublicKey}, nil
+	case "EdDSA":
+		edKey, ok := privateKey.(ed25519.PrivateKey)
+		if !ok {
+			return Key{}, errors.New("EdDSA requires an Ed25519 private key")
+		}
+		return Key{Method: jwt.SigningMethodEdDSA, signKey: edKey, verifyKey: edKey.Public()}, nil
+	default:
+		return Key{}, fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+}
+
+// 지금 서명에 사용할 키, NotBefore 가 지난 키 중 가장 최근 키를 고른다
+func (s *KeySet) SigningKey(now time.Time) (Key, error) {
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		key := s.keys[i]
+		if key.NotBefore.After(now) {
+			continue
+		}
+		if !key.NotAfter.IsZero() && !key.NotAfter.After(now) {
+			continue
+		}
+		return key, nil
+	}
+
+	return Key{}, errors.New("no signing key is active")
+}
+
+// 서명에서 빠진 키도 마지막으로 서명한 토큰이 만료될 때까지는 검증에 사용한다
+func (s *KeySet) VerificationKey(kid string, now time.Time) (Key, bool) {
+	for _, key := range s.keys {
+		if key.ID != kid {
+			continue
+		}
+		if !key.NotAfter.IsZero() && now.After(key.NotAfter.Add(AccessTokenTTL)) {
+			return Key{}, false
+		}
+		return key, true
+	}
+
+	return Key{}, false
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// 검증에 쓸 수 있는 공개 키 목록, 예약된 다음 키도 미리 내보내 다른 서비스가 캐시할 수 있게 한다
+// HS256 키는 공개할 수 없으므로 포함하지 않는다
+func (s *KeySet) JWKS(now time.Time) JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, key := range s.keys {
+		if _, ok := s.VerificationKey(key.ID, now); !ok {
+			continue
+		}
+
+		switch publicKey := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "RSA",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				N:   encodeSegment(publicKey.N.Bytes()),
+				E:   encodeSegment(bigEndian(publicKey.E)),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "OKP",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Crv: "Ed25519",
+				X:   encodeSegment(publicKey),
+			})
+		}
+	}
+
+	return jwks
+}
+
+func bigEndian(n int) []byte {
+	var bytes []byte
+	for ; n > 0; n >>= 8 {
+		bytes = append([]byte{byte(n)}, bytes...)
+	}
+	return bytes
+}
+
+func encodeSegment(bytes []byte) string {
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
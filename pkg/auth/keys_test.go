@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testKeyEntry struct {
+	ID             string    `json:"kid"`
+	Algorithm      string    `json:"algorithm"`
+	PrivateKeyFile string    `json:"private_key_file"`
+	NotBefore      time.Time `json:"not_before"`
+	NotAfter       time.Time `json:"not_after,omitempty"`
+}
+
+func writeTestKeySet(t *testing.T, entries []testKeyEntry) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for i, entry := range entries {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		entries[i].Algorithm = "EdDSA"
+		entries[i].PrivateKeyFile = entry.ID + ".pem"
+		data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		if err := os.WriteFile(filepath.Join(dir, entries[i].PrivateKeyFile), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"keys": entries})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "keys.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func jwksKids(keySet *KeySet, now time.Time) []string {
+	var kids []string
+	for _, key := range keySet.JWKS(now).Keys {
+		kids = append(kids, key.Kid)
+	}
+	return kids
+}
+
+// not_after 가 없는 이전 키도 다음 키로 바뀐 뒤 AccessTokenTTL 이 지나면 검증에서 빠진다
+func TestKeySetRetiresPreviousKey(t *testing.T) {
+	rotation := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	keySet, err := LoadKeySet(writeTestKeySet(t, []testKeyEntry{
+		{ID: "new", NotBefore: rotation},
+		{ID: "old", NotBefore: rotation.Add(-30 * 24 * time.Hour)},
+	}))
+	if err != nil {
+		t.Fatalf("LoadKeySet returned error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		signing     string
+		oldVerifies bool
+	}{
+		{name: "before rotation", now: rotation.Add(-time.Second), signing: "old", oldVerifies: true},
+		{name: "right after rotation", now: rotation, signing: "new", oldVerifies: true},
+		{name: "until old tokens expire", now: rotation.Add(AccessTokenTTL), signing: "new", oldVerifies: true},
+		{name: "after old tokens expire", now: rotation.Add(AccessTokenTTL + time.Second), signing: "new", oldVerifies: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := keySet.SigningKey(tt.now)
+			if err != nil {
+				t.Fatalf("SigningKey returned error: %v", err)
+			}
+			if key.ID != tt.signing {
+				t.Errorf("signing key = %s, want %s", key.ID, tt.signing)
+			}
+
+			if _, ok := keySet.VerificationKey("old", tt.now); ok != tt.oldVerifies {
+				t.Errorf("old key verifies = %v, want %v", ok, tt.oldVerifies)
+			}
+			if _, ok := keySet.VerificationKey("new", tt.now); !ok {
+				t.Errorf("new key does not verify")
+			}
+
+			published := false
+			for _, kid := range jwksKids(keySet, tt.now) {
+				published = published || kid == "old"
+			}
+			if published != tt.oldVerifies {
+				t.Errorf("old key published = %v, want %v", published, tt.oldVerifies)
+			}
+		})
+	}
+}
+
+func TestKeySetKeepsEarlierNotAfter(t *testing.T) {
+	rotation := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	retired := rotation.Add(-24 * time.Hour)
+	keySet, err := LoadKeySet(writeTestKeySet(t, []testKeyEntry{
+		{ID: "old", NotBefore: rotation.Add(-30 * 24 * time.Hour), NotAfter: retired},
+		{ID: "new", NotBefore: rotation},
+	}))
+	if err != nil {
+		t.Fatalf("LoadKeySet returned error: %v", err)
+	}
+
+	if _, ok := keySet.VerificationKey("old", retired.Add(AccessTokenTTL+time.Second)); ok {
+		t.Errorf("old key verifies after its own not_after")
+	}
+	if _, ok := keySet.VerificationKey("new", rotation.Add(365*24*time.Hour)); !ok {
+		t.Errorf("latest key without not_after stops verifying")
+	}
+}
@@ -0,0 +1,19 @@
+package auth
+
+import "github.com/YehyeokBang/Simple-SNS/pkg/db"
+
+var roleRanks = map[string]int{
+	db.RoleUser:      1,
+	db.RoleModerator: 2,
+	db.RoleAdmin:     3,
+}
+
+// role 이 required 이상의 권한을 가졌는지 확인한다, 알 수 없는 역할은 아무 권한도 없다
+func HasRole(role, required string) bool {
+	return roleRanks[role] >= roleRanks[required]
+}
+
+func IsValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
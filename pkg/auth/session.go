@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+)
+
+// 요청마다 last_seen_at 을 쓰지 않도록 이 간격이 지났을 때만 갱신한다
+const sessionTouchInterval = time.Minute
+
+type SessionStore interface {
+	// 세션이 폐기되지 않았으면 마지막 접속 시각을 갱신하고 true 를 반환한다
+	Touch(sessionID string) bool
+}
+
+type GormSessionStore struct {
+	DB *gorm.DB
+}
+
+func NewGormSessionStore(db *gorm.DB) *GormSessionStore {
+	return &GormSessionStore{
+		DB: db,
+	}
+}
+
+func (s *GormSessionStore) Touch(sessionID string) bool {
+	var session db.Session
+	result := s.DB.Where("id = ?", sessionID).First(&session)
+	if result.Error != nil || session.RevokedAt != nil {
+		return false
+	}
+
+	if time.Since(session.LastSeenAt) > sessionTouchInterval {
+		s.DB.Model(&session).UpdateColumn("last_seen_at", time.Now())
+	}
+
+	return true
+}
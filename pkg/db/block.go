@@ -0,0 +1,21 @@
+package db
+
+import "time"
+
+// 차단하면 서로의 게시글과 댓글이 보이지 않고 팔로우, 댓글, 1:1 메시지를 주고받을 수 없다
+type Block struct {
+	ID        uint `gorm:"primaryKey"`
+	BlockerID uint `gorm:"uniqueIndex:idx_blocker_blocked"`
+	BlockedID uint `gorm:"uniqueIndex:idx_blocker_blocked;index"`
+	Blocked   User
+	CreatedAt time.Time
+}
+
+// 뮤트하면 목록과 검색 결과, 댓글 목록에서 상대방의 글이 보이지 않는다, 상대방은 알 수 없다
+type Mute struct {
+	ID        uint `gorm:"primaryKey"`
+	MuterID   uint `gorm:"uniqueIndex:idx_muter_muted"`
+	MutedID   uint `gorm:"uniqueIndex:idx_muter_muted;index"`
+	Muted     User
+	CreatedAt time.Time
+}
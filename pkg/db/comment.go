@@ -13,10 +13,14 @@ type Comment struct {
 	PostID          uint
 	Post            Post
 	Content         string `gorm:"type:varchar(500)"`
+	LikeCount       uint   `gorm:"not null;default:0"` // comment_likes 의 행 수를 좋아요/취소 시 함께 갱신
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       gorm.DeletedAt
 	ParentCommentID *uint     // 부모 댓글의 ID를 저장
 	ParentComment   *Comment  // 부모 댓글을 참조
 	ChildComments   []Comment `gorm:"foreignkey:ParentCommentID"`
+	Mentions        []Mention `gorm:"foreignKey:CommentID"`
+
+	HiddenAt *time.Time `gorm:"index"` // 관리자가 숨긴 시각
 }
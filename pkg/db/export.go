@@ -0,0 +1,23 @@
+package db
+
+import "time"
+
+const (
+	DataExportStatusPending   = "pending"
+	DataExportStatusRunning   = "running"
+	DataExportStatusCompleted = "completed"
+	DataExportStatusFailed    = "failed"
+)
+
+// 백그라운드에서 만드는 개인 데이터 내보내기 작업, 완성된 ZIP 파일은 FilePath 에 저장된다
+type DataExport struct {
+	ID          uint   `gorm:"primaryKey"`
+	UserID      uint   `gorm:"index"`
+	Status      string `gorm:"type:varchar(20);index"`
+	FilePath    string
+	Size        int64
+	Error       string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time // 완성된 뒤 이 시각이 지나면 파일을 지운다
+}
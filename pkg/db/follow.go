@@ -0,0 +1,12 @@
+package db
+
+import "time"
+
+type Follow struct {
+	ID          uint `gorm:"primaryKey"`
+	FollowerID  uint `gorm:"uniqueIndex:idx_follower_following"`
+	Follower    User
+	FollowingID uint `gorm:"uniqueIndex:idx_follower_following;index"`
+	Following   User
+	CreatedAt   time.Time
+}
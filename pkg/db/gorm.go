@@ -39,6 +39,17 @@ func MustNewGormDB(config *config.Config) *gorm.DB {
 		log.Fatalf("failed to migrate user: %v", err)
 	}
 
+	// is_admin 컬럼은 role 로 대체되었다
+	if db.Migrator().HasColumn(&User{}, "is_admin") {
+		err = db.Exec("UPDATE users SET role = ? WHERE is_admin = ?", RoleAdmin, true).Error
+		if err == nil {
+			err = db.Migrator().DropColumn(&User{}, "is_admin")
+		}
+		if err != nil {
+			log.Fatalf("failed to migrate user role: %v", err)
+		}
+	}
+
 	err = db.AutoMigrate(&Post{})
 	if err != nil {
 		log.Fatalf("failed to migrate post: %v", err)
@@ -49,5 +60,70 @@ func MustNewGormDB(config *config.Config) *gorm.DB {
 		log.Fatalf("failed to migrate comment: %v", err)
 	}
 
+	err = db.AutoMigrate(&Follow{})
+	if err != nil {
+		log.Fatalf("failed to migrate follow: %v", err)
+	}
+
+	err = db.AutoMigrate(&TimelineEntry{})
+	if err != nil {
+		log.Fatalf("failed to migrate timeline entry: %v", err)
+	}
+
+	err = db.AutoMigrate(&PostLike{})
+	if err != nil {
+		log.Fatalf("failed to migrate post like: %v", err)
+	}
+
+	err = db.AutoMigrate(&CommentLike{})
+	if err != nil {
+		log.Fatalf("failed to migrate comment like: %v", err)
+	}
+
+	err = db.AutoMigrate(&Tag{})
+	if err != nil {
+		log.Fatalf("failed to migrate tag: %v", err)
+	}
+
+	err = db.AutoMigrate(&Mention{})
+	if err != nil {
+		log.Fatalf("failed to migrate mention: %v", err)
+	}
+
+	err = db.AutoMigrate(&Notification{})
+	if err != nil {
+		log.Fatalf("failed to migrate notification: %v", err)
+	}
+
+	err = db.AutoMigrate(&Conversation{}, &ConversationMember{}, &Message{})
+	if err != nil {
+		log.Fatalf("failed to migrate message: %v", err)
+	}
+
+	err = db.AutoMigrate(&Session{}, &RefreshToken{}, &RevokedToken{}, &PasswordResetToken{})
+	if err != nil {
+		log.Fatalf("failed to migrate token: %v", err)
+	}
+
+	err = db.AutoMigrate(&DataExport{})
+	if err != nil {
+		log.Fatalf("failed to migrate data export: %v", err)
+	}
+
+	err = db.AutoMigrate(&Block{}, &Mute{})
+	if err != nil {
+		log.Fatalf("failed to migrate block: %v", err)
+	}
+
+	err = db.AutoMigrate(&Report{}, &ModerationAction{})
+	if err != nil {
+		log.Fatalf("failed to migrate moderation: %v", err)
+	}
+
+	err = db.AutoMigrate(&Media{})
+	if err != nil {
+		log.Fatalf("failed to migrate media: %v", err)
+	}
+
 	return db
 }
@@ -0,0 +1,21 @@
+package db
+
+import "time"
+
+type PostLike struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"uniqueIndex:idx_post_like_user_post"`
+	User      User
+	PostID    uint `gorm:"uniqueIndex:idx_post_like_user_post;index"`
+	Post      Post
+	CreatedAt time.Time
+}
+
+type CommentLike struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"uniqueIndex:idx_comment_like_user_comment"`
+	User      User
+	CommentID uint `gorm:"uniqueIndex:idx_comment_like_user_comment;index"`
+	Comment   Comment
+	CreatedAt time.Time
+}
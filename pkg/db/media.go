@@ -0,0 +1,17 @@
+package db
+
+import "time"
+
+// 업로드된 첨부 파일, 파일 내용은 storage 에 StorageKey 로 저장된다
+// 업로드한 뒤 게시글을 쓸 때 media_ids 로 넘기면 PostID 가 채워진다
+type Media struct {
+	ID          uint   `gorm:"primaryKey"`
+	UserID      uint   `gorm:"index"`
+	PostID      *uint  `gorm:"index"`
+	StorageKey  string `gorm:"type:varchar(255);unique"`
+	FileName    string `gorm:"type:varchar(255)"`
+	ContentType string `gorm:"type:varchar(100)"`
+	Size        int64
+	Checksum    string `gorm:"type:char(64)"` // sha256 hex
+	CreatedAt   time.Time
+}
@@ -0,0 +1,16 @@
+package db
+
+import "time"
+
+// 게시글 또는 댓글 본문에서 다른 사용자를 언급한 위치
+// PostID, CommentID 중 하나만 채워진다
+type Mention struct {
+	ID        uint  `gorm:"primaryKey"`
+	PostID    *uint `gorm:"index"`
+	CommentID *uint `gorm:"index"`
+	UserID    uint  `gorm:"index"`
+	User      User
+	Offset    uint
+	Length    uint
+	CreatedAt time.Time
+}
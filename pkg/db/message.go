@@ -0,0 +1,32 @@
+package db
+
+import "time"
+
+type Conversation struct {
+	ID            uint `gorm:"primaryKey"`
+	IsGroup       bool
+	DirectKey     *string   `gorm:"type:varchar(50);unique"` // 1:1 대화가 중복으로 만들어지지 않도록 "작은 ID:큰 ID" 를 저장
+	LastMessageAt time.Time `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Members []ConversationMember
+}
+
+type ConversationMember struct {
+	ID                uint `gorm:"primaryKey"`
+	ConversationID    uint `gorm:"uniqueIndex:idx_conversation_member"`
+	UserID            uint `gorm:"uniqueIndex:idx_conversation_member;index"`
+	User              User
+	LastReadMessageID uint // 읽음 표시, 이 ID 이하의 메시지는 읽은 것으로 본다
+	CreatedAt         time.Time
+}
+
+type Message struct {
+	ID             uint `gorm:"primaryKey"`
+	ConversationID uint `gorm:"index"`
+	SenderID       uint
+	Sender         User
+	Content        string `gorm:"type:varchar(1000)"`
+	CreatedAt      time.Time
+}
@@ -0,0 +1,30 @@
+package db
+
+import "time"
+
+const (
+	ModerationActionHidePost      = "hide_post"
+	ModerationActionHideComment   = "hide_comment"
+	ModerationActionSuspendUser   = "suspend_user"
+	ModerationActionResolveReport = "resolve_report"
+	ModerationActionSetRole       = "set_role"
+
+	// 모더레이터가 다른 사용자의 글을 기존 RPC 로 수정/삭제한 경우
+	ModerationActionEditPost      = "edit_post"
+	ModerationActionDeletePost    = "delete_post"
+	ModerationActionEditComment   = "edit_comment"
+	ModerationActionDeleteComment = "delete_comment"
+)
+
+// 관리자 조치 기록, 숨긴 글은 지우지 않고 hidden_at 만 채우므로 이 기록과 함께 나중에 확인할 수 있다
+type ModerationAction struct {
+	ID          uint   `gorm:"primaryKey"`
+	ModeratorID uint   `gorm:"index"`
+	Moderator   User   `gorm:"foreignKey:ModeratorID"`
+	Action      string `gorm:"type:varchar(30)"`
+	TargetType  string `gorm:"type:varchar(20);index:idx_moderation_target"`
+	TargetID    uint   `gorm:"index:idx_moderation_target"`
+	ReportID    *uint
+	Reason      string `gorm:"type:varchar(500)"`
+	CreatedAt   time.Time
+}
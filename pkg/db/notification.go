@@ -0,0 +1,24 @@
+package db
+
+import "time"
+
+const (
+	NotificationTypeComment     = "comment"
+	NotificationTypeReply       = "reply"
+	NotificationTypePostLike    = "post_like"
+	NotificationTypeCommentLike = "comment_like"
+	NotificationTypeFollow      = "follow"
+	NotificationTypeMention     = "mention"
+)
+
+type Notification struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"index:idx_notification_user_read"` // 알림을 받는 사용자
+	ActorID   uint // 알림을 발생시킨 사용자
+	Actor     User
+	Type      string `gorm:"type:varchar(30)"`
+	PostID    *uint
+	CommentID *uint
+	ReadAt    *time.Time `gorm:"index:idx_notification_user_read"`
+	CreatedAt time.Time
+}
@@ -7,14 +7,21 @@ import (
 )
 
 type Post struct {
-	ID        uint `gorm:"primaryKey"`
-	UserID    uint
-	User      User
-	Title     string `gorm:"type:varchar(100)"`
-	Content   string `gorm:"type:varchar(500)"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeleteAt  gorm.DeletedAt
+	ID         uint `gorm:"primaryKey"`
+	UserID     uint
+	User       User
+	Title      string `gorm:"type:varchar(100)"`
+	Content    string `gorm:"type:varchar(500)"`
+	LikeCount  uint   `gorm:"not null;default:0"` // post_likes 의 행 수를 좋아요/취소 시 함께 갱신
+	Visibility string `gorm:"type:varchar(20);not null;default:public;index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeleteAt   gorm.DeletedAt
+
+	HiddenAt *time.Time `gorm:"index"` // 관리자가 숨긴 시각, 숨긴 글은 작성자를 포함한 누구에게도 보이지 않는다
 
 	Comments []Comment `gorm:"foreignKey:PostID"`
+	Tags     []Tag     `gorm:"many2many:post_tags;"`
+	Mentions []Mention `gorm:"foreignKey:PostID"`
+	Media    []Media   `gorm:"foreignKey:PostID"`
 }
@@ -0,0 +1,41 @@
+package db
+
+import "time"
+
+const (
+	ReportTargetPost    = "post"
+	ReportTargetComment = "comment"
+	ReportTargetUser    = "user"
+)
+
+const (
+	ReportReasonSpam          = "spam"
+	ReportReasonHarassment    = "harassment"
+	ReportReasonHateSpeech    = "hate_speech"
+	ReportReasonViolence      = "violence"
+	ReportReasonSexualContent = "sexual_content"
+	ReportReasonOther         = "other"
+)
+
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusResolved  = "resolved"  // 조치를 취하고 닫음
+	ReportStatusDismissed = "dismissed" // 문제가 없다고 보고 닫음
+)
+
+// 같은 사용자가 같은 대상을 여러 번 신고할 수 없다
+type Report struct {
+	ID           uint   `gorm:"primaryKey"`
+	ReporterID   uint   `gorm:"uniqueIndex:idx_report_reporter_target"`
+	Reporter     User   `gorm:"foreignKey:ReporterID"`
+	TargetType   string `gorm:"type:varchar(20);uniqueIndex:idx_report_reporter_target;index:idx_report_target"`
+	TargetID     uint   `gorm:"uniqueIndex:idx_report_reporter_target;index:idx_report_target"`
+	Reason       string `gorm:"type:varchar(30)"`
+	Detail       string `gorm:"type:varchar(500)"`
+	Status       string `gorm:"type:varchar(20);not null;default:open;index"`
+	ResolvedByID *uint
+	ResolvedBy   *User  `gorm:"foreignKey:ResolvedByID"`
+	Note         string `gorm:"type:varchar(500)"` // 처리한 관리자가 남긴 메모
+	ResolvedAt   *time.Time
+	CreatedAt    time.Time
+}
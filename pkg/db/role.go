@@ -0,0 +1,8 @@
+package db
+
+// 사용자 역할, 아래로 갈수록 권한이 크다
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator" // 신고 처리, 다른 사용자의 글 수정/삭제
+	RoleAdmin     = "admin"     // 모더레이터 권한 + 사용자 정지, 역할 변경
+)
@@ -0,0 +1,11 @@
+package db
+
+import "time"
+
+type Tag struct {
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"type:varchar(100);unique"`
+	CreatedAt time.Time
+
+	Posts []Post `gorm:"many2many:post_tags;"`
+}
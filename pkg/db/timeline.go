@@ -0,0 +1,12 @@
+package db
+
+import "time"
+
+// fan-out-on-write 방식에서 사용자별 홈 타임라인에 미리 넣어두는 게시글
+type TimelineEntry struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"uniqueIndex:idx_timeline_user_post"`
+	PostID    uint `gorm:"uniqueIndex:idx_timeline_user_post;index"`
+	Post      Post
+	CreatedAt time.Time
+}
@@ -0,0 +1,43 @@
+package db
+
+import "time"
+
+// 로그인 한 번이 하나의 세션이 된다
+type Session struct {
+	ID         uint `gorm:"primaryKey"`
+	UserID     uint `gorm:"index"`
+	UserAgent  string
+	IPAddress  string `gorm:"type:varchar(45)"`
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+// 재발급할 때마다 같은 세션 안에서 새 토큰으로 교체된다
+type RefreshToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	SessionID uint   `gorm:"index"`
+	TokenHash string `gorm:"type:varchar(64);unique"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time // 재발급에 사용된 시각, 사용된 토큰이 다시 오면 탈취로 본다
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// 만료 전에 폐기된 access token 의 jti
+type RevokedToken struct {
+	JTI       string    `gorm:"type:varchar(64);primaryKey"`
+	ExpiresAt time.Time `gorm:"index"`
+	CreatedAt time.Time
+}
+
+// 원문은 메일로만 보내고 해시만 저장한다, 한 번 사용하면 다시 쓸 수 없다
+type PasswordResetToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	TokenHash string `gorm:"type:varchar(64);unique"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
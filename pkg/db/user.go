@@ -1,6 +1,10 @@
 package db
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	ID        uint
@@ -10,9 +14,20 @@ type User struct {
 	Age       uint32
 	Sex       string `gorm:"type:varchar(100)"`
 	Birthday  *time.Time
-	Introduce string `gorm:"type:varchar(100)"`
+	Introduce string  `gorm:"type:varchar(100)"`
+	Email     *string `gorm:"type:varchar(255);unique"` // 비밀번호 재설정 메일을 받을 주소
+
+	// 다른 사용자에게 프로필을 보여줄 때 필드별 공개 범위
+	AgeVisibility      string `gorm:"type:varchar(20);not null;default:private"`
+	SexVisibility      string `gorm:"type:varchar(20);not null;default:private"`
+	BirthdayVisibility string `gorm:"type:varchar(20);not null;default:private"`
+
+	Role           string     `gorm:"type:varchar(20);not null;default:user"` // access token 의 role 클레임으로 전달된다
+	SuspendedUntil *time.Time // 이 시각까지 로그인할 수 없다
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 
 	Posts []Post
 }
@@ -0,0 +1,8 @@
+package db
+
+// 공개 범위, 작성자(본인)는 항상 볼 수 있다
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityPrivate   = "private"
+)
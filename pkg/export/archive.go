@@ -0,0 +1,272 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+)
+
+// 비밀번호 해시처럼 내보내면 안 되는 값이 섞이지 않도록 db 모델 대신 아래 구조체로 옮겨 담는다
+type profile struct {
+	UserID    string     `json:"user_id"`
+	Name      string     `json:"name"`
+	Age       uint32     `json:"age"`
+	Sex       string     `json:"sex"`
+	Birthday  *time.Time `json:"birthday,omitempty"`
+	Introduce string     `json:"introduce"`
+	Email     *string    `json:"email,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type post struct {
+	ID        uint      `json:"id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	Tags      []string  `json:"tags"`
+	LikeCount uint      `json:"like_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type comment struct {
+	ID              uint      `json:"id"`
+	PostID          uint      `json:"post_id"`
+	ParentCommentID *uint     `json:"parent_comment_id,omitempty"`
+	Content         string    `json:"content"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// 파일 내용은 담지 않고 업로드한 파일의 정보만 내보낸다
+type media struct {
+	ID          uint      `json:"id"`
+	PostID      *uint     `json:"post_id,omitempty"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type like struct {
+	PostID    uint      `json:"post_id,omitempty"`
+	CommentID uint      `json:"comment_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type follow struct {
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type message struct {
+	ConversationID uint      `json:"conversation_id"`
+	Content        string    `json:"content"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type session struct {
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// 사용자의 데이터를 항목별 JSON 파일로 묶은 ZIP 을 path 에 쓰고 파일 크기를 반환한다
+func writeArchive(gormDB *gorm.DB, userID uint, path string) (int64, error) {
+	files, err := collect(gormDB, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	for _, entry := range files {
+		writer, err := archive.Create(entry.name)
+		if err != nil {
+			return 0, err
+		}
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(entry.data); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+type archiveFile struct {
+	name string
+	data interface{}
+}
+
+func collect(gormDB *gorm.DB, userID uint) ([]archiveFile, error) {
+	var user db.User
+	if err := gormDB.First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+
+	var dbPosts []db.Post
+	if err := gormDB.Where("user_id = ?", userID).Preload("Tags").Order("id").Find(&dbPosts).Error; err != nil {
+		return nil, err
+	}
+
+	posts := make([]post, 0, len(dbPosts))
+	for _, p := range dbPosts {
+		tags := make([]string, 0, len(p.Tags))
+		for _, tag := range p.Tags {
+			tags = append(tags, tag.Name)
+		}
+
+		posts = append(posts, post{
+			ID:        p.ID,
+			Title:     p.Title,
+			Content:   p.Content,
+			Tags:      tags,
+			LikeCount: p.LikeCount,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+		})
+	}
+
+	var dbComments []db.Comment
+	if err := gormDB.Where("user_id = ?", userID).Order("id").Find(&dbComments).Error; err != nil {
+		return nil, err
+	}
+
+	comments := make([]comment, 0, len(dbComments))
+	for _, c := range dbComments {
+		comments = append(comments, comment{
+			ID:              c.ID,
+			PostID:          c.PostID,
+			ParentCommentID: c.ParentCommentID,
+			Content:         c.Content,
+			CreatedAt:       c.CreatedAt,
+			UpdatedAt:       c.UpdatedAt,
+		})
+	}
+
+	var dbMedia []db.Media
+	if err := gormDB.Where("user_id = ?", userID).Order("id").Find(&dbMedia).Error; err != nil {
+		return nil, err
+	}
+
+	uploads := make([]media, 0, len(dbMedia))
+	for _, m := range dbMedia {
+		uploads = append(uploads, media{
+			ID:          m.ID,
+			PostID:      m.PostID,
+			FileName:    m.FileName,
+			ContentType: m.ContentType,
+			Size:        m.Size,
+			SHA256:      m.Checksum,
+			CreatedAt:   m.CreatedAt,
+		})
+	}
+
+	var postLikes []db.PostLike
+	if err := gormDB.Where("user_id = ?", userID).Order("id").Find(&postLikes).Error; err != nil {
+		return nil, err
+	}
+
+	var commentLikes []db.CommentLike
+	if err := gormDB.Where("user_id = ?", userID).Order("id").Find(&commentLikes).Error; err != nil {
+		return nil, err
+	}
+
+	likes := make([]like, 0, len(postLikes)+len(commentLikes))
+	for _, l := range postLikes {
+		likes = append(likes, like{PostID: l.PostID, CreatedAt: l.CreatedAt})
+	}
+	for _, l := range commentLikes {
+		likes = append(likes, like{CommentID: l.CommentID, CreatedAt: l.CreatedAt})
+	}
+
+	var followingRows []db.Follow
+	if err := gormDB.Where("follower_id = ?", userID).Preload("Following").Order("id").Find(&followingRows).Error; err != nil {
+		return nil, err
+	}
+
+	following := make([]follow, 0, len(followingRows))
+	for _, f := range followingRows {
+		following = append(following, follow{UserID: f.Following.UserId, CreatedAt: f.CreatedAt})
+	}
+
+	var followerRows []db.Follow
+	if err := gormDB.Where("following_id = ?", userID).Preload("Follower").Order("id").Find(&followerRows).Error; err != nil {
+		return nil, err
+	}
+
+	followers := make([]follow, 0, len(followerRows))
+	for _, f := range followerRows {
+		followers = append(followers, follow{UserID: f.Follower.UserId, CreatedAt: f.CreatedAt})
+	}
+
+	var dbMessages []db.Message
+	if err := gormDB.Where("sender_id = ?", userID).Order("id").Find(&dbMessages).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]message, 0, len(dbMessages))
+	for _, m := range dbMessages {
+		messages = append(messages, message{ConversationID: m.ConversationID, Content: m.Content, CreatedAt: m.CreatedAt})
+	}
+
+	var dbSessions []db.Session
+	if err := gormDB.Where("user_id = ?", userID).Order("id").Find(&dbSessions).Error; err != nil {
+		return nil, err
+	}
+
+	sessions := make([]session, 0, len(dbSessions))
+	for _, s := range dbSessions {
+		sessions = append(sessions, session{
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			RevokedAt:  s.RevokedAt,
+		})
+	}
+
+	return []archiveFile{
+		{name: "profile.json", data: profile{
+			UserID:    user.UserId,
+			Name:      user.Name,
+			Age:       user.Age,
+			Sex:       user.Sex,
+			Birthday:  user.Birthday,
+			Introduce: user.Introduce,
+			Email:     user.Email,
+			CreatedAt: user.CreatedAt,
+		}},
+		{name: "posts.json", data: posts},
+		{name: "comments.json", data: comments},
+		{name: "media.json", data: uploads},
+		{name: "likes.json", data: likes},
+		{name: "following.json", data: following},
+		{name: "followers.json", data: followers},
+		{name: "messages.json", data: messages},
+		{name: "sessions.json", data: sessions},
+	}, nil
+}
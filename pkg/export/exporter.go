@@ -0,0 +1,160 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+)
+
+const (
+	// 완성된 내보내기 파일을 보관하는 기간
+	retention = 7 * 24 * time.Hour
+
+	// 대기 중인 작업을 쌓아둘 수 있는 개수, 넘치면 작업은 실패로 기록된다
+	queueSize = 100
+)
+
+var ErrQueueFull = errors.New("export: queue is full")
+
+// Exporter 는 DataExport 작업을 하나씩 처리하는 백그라운드 작업자
+type Exporter struct {
+	DB   *gorm.DB
+	Dir  string
+	jobs chan uint
+}
+
+func MustNewExporter(db *gorm.DB, dir string) *Exporter {
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "simple-sns-exports")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		log.Fatalf("failed to create export directory: %v", err)
+	}
+
+	return &Exporter{
+		DB:   db,
+		Dir:  dir,
+		jobs: make(chan uint, queueSize),
+	}
+}
+
+// 서버가 재시작되어 처리되지 못한 작업을 다시 넣고 작업자를 띄운다
+func (e *Exporter) Start() {
+	e.DB.Model(&db.DataExport{}).
+		Where("status = ?", db.DataExportStatusRunning).
+		Update("status", db.DataExportStatusPending)
+
+	var pendingIDs []uint
+	e.DB.Model(&db.DataExport{}).
+		Where("status = ?", db.DataExportStatusPending).
+		Order("id").
+		Pluck("id", &pendingIDs)
+
+	go e.work()
+
+	for _, id := range pendingIDs {
+		if err := e.Enqueue(id); err != nil {
+			log.Printf("export: failed to enqueue export %d: %v", id, err)
+		}
+	}
+}
+
+// 큐가 가득 차면 작업을 실패로 기록하고 ErrQueueFull 을 반환한다, 사용자는 나중에 다시 요청할 수 있다
+func (e *Exporter) Enqueue(exportID uint) error {
+	select {
+	case e.jobs <- exportID:
+		return nil
+	default:
+		e.DB.Model(&db.DataExport{}).
+			Where("id = ? AND status = ?", exportID, db.DataExportStatusPending).
+			Updates(map[string]interface{}{
+				"status": db.DataExportStatusFailed,
+				"error":  "export queue is full",
+			})
+		return ErrQueueFull
+	}
+}
+
+// 사용자의 내보내기 파일과 기록을 모두 지운다
+func (e *Exporter) DeleteAll(userID uint) error {
+	var exports []db.DataExport
+	if err := e.DB.Where("user_id = ?", userID).Find(&exports).Error; err != nil {
+		return err
+	}
+
+	for _, export := range exports {
+		e.removeFile(export)
+	}
+
+	return e.DB.Where("user_id = ?", userID).Delete(&db.DataExport{}).Error
+}
+
+func (e *Exporter) work() {
+	for id := range e.jobs {
+		e.removeExpired()
+		e.run(id)
+	}
+}
+
+func (e *Exporter) run(exportID uint) {
+	result := e.DB.Model(&db.DataExport{}).
+		Where("id = ? AND status = ?", exportID, db.DataExportStatusPending).
+		Update("status", db.DataExportStatusRunning)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return
+	}
+
+	var export db.DataExport
+	if err := e.DB.First(&export, exportID).Error; err != nil {
+		return
+	}
+
+	path := filepath.Join(e.Dir, fmt.Sprintf("export-%d-%d.zip", export.UserID, export.ID))
+	size, err := writeArchive(e.DB, export.UserID, path)
+	if err != nil {
+		log.Printf("export: failed to export data of user %d: %v", export.UserID, err)
+		os.Remove(path)
+		e.DB.Model(&export).Updates(map[string]interface{}{
+			"status": db.DataExportStatusFailed,
+			"error":  "failed to generate export",
+		})
+		return
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(retention)
+	e.DB.Model(&export).Updates(map[string]interface{}{
+		"status":       db.DataExportStatusCompleted,
+		"file_path":    path,
+		"size":         size,
+		"completed_at": now,
+		"expires_at":   expiresAt,
+	})
+}
+
+func (e *Exporter) removeExpired() {
+	var exports []db.DataExport
+	e.DB.Where("expires_at < ?", time.Now()).Find(&exports)
+
+	for _, export := range exports {
+		e.removeFile(export)
+		e.DB.Delete(&export)
+	}
+}
+
+func (e *Exporter) removeFile(export db.DataExport) {
+	if export.FilePath == "" {
+		return
+	}
+
+	if err := os.Remove(export.FilePath); err != nil && !os.IsNotExist(err) {
+		log.Printf("export: failed to remove %s: %v", export.FilePath, err)
+	}
+}
@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// FileMailer 는 메일을 보내는 대신 디렉터리에 .eml 파일로 남긴다, 로컬 개발용
+type FileMailer struct {
+	Dir   string
+	count atomic.Uint64
+}
+
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &FileMailer{
+		Dir: dir,
+	}, nil
+}
+
+func (m *FileMailer) Send(message Message) error {
+	name := fmt.Sprintf("%d-%d.eml", time.Now().UnixNano(), m.count.Add(1))
+	return os.WriteFile(filepath.Join(m.Dir, name), format("noreply@localhost", message), 0o600)
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"log"
+
+	"github.com/YehyeokBang/Simple-SNS/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(message Message) error
+}
+
+// SMTP_ADDR 가 있으면 SMTP 로 보내고, MAIL_DIR 가 있으면 파일로 남기고, 둘 다 없으면 메모리에만 쌓는다
+func MustNewMailer(cfg *config.Config) Mailer {
+	switch {
+	case cfg.SMTPAddr != "":
+		if cfg.MailFrom == "" {
+			log.Fatalf("environment variable MAIL_FROM is not set")
+		}
+		return NewSMTPMailer(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+	case cfg.MailDir != "":
+		mailer, err := NewFileMailer(cfg.MailDir)
+		if err != nil {
+			log.Fatalf("failed to create mail directory: %v", err)
+		}
+		return mailer
+	default:
+		log.Printf("mail: SMTP_ADDR and MAIL_DIR are not set, mails are kept in memory only")
+		return NewMemoryMailer()
+	}
+}
@@ -0,0 +1,119 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cursor 는 (created_at, id) 내림차순 목록에서 마지막으로 내려준 행의 위치
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+func EncodeToken(cursor Cursor) string {
+	raw := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixNano(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// 빈 token 은 첫 페이지를 의미하므로 nil 을 반환
+func DecodeToken(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 {
+		return nil, ErrInvalidPageToken
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &Cursor{
+		CreatedAt: time.Unix(0, nanos),
+		ID:        uint(id),
+	}, nil
+}
+
+func PageSize(requested uint32) int {
+	if requested == 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return int(requested)
+}
+
+// 다음 페이지 존재 여부를 알기 위해 size 보다 하나 더 조회한다
+func Scope(table string, cursor *Cursor, size int) func(db *gorm.DB) *gorm.DB {
+	return ScopeBy(table, "created_at", cursor, size)
+}
+
+// ScopeBy 는 created_at 대신 column 기준으로 정렬한다, 이때 Cursor.CreatedAt 에는 column 값이 들어간다
+func ScopeBy(table, column string, cursor *Cursor, size int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil {
+			db = db.Where(
+				fmt.Sprintf("(%[1]s.%[2]s < ? OR (%[1]s.%[2]s = ? AND %[1]s.id < ?))", table, column),
+				cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+			)
+		}
+
+		return db.Order(table + "." + column + " desc").
+			Order(table + ".id desc").
+			Limit(size + 1)
+	}
+}
+
+// ScopeAsc 는 Scope 와 같지만 오래된 순(created_at asc, id asc)으로 조회한다
+func ScopeAsc(table string, cursor *Cursor, size int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil {
+			db = db.Where(
+				fmt.Sprintf("(%[1]s.created_at > ? OR (%[1]s.created_at = ? AND %[1]s.id > ?))", table),
+				cursor.CreatedAt, cursor.CreatedAt, cursor.ID,
+			)
+		}
+
+		return db.Order(table + ".created_at asc").
+			Order(table + ".id asc").
+			Limit(size + 1)
+	}
+}
+
+// Scope 로 조회한 결과를 size 만큼 자르고 다음 페이지 token 을 만든다
+func Next[T any](items []T, size int, cursorOf func(T) Cursor) ([]T, string) {
+	if len(items) <= size {
+		return items, ""
+	}
+
+	items = items[:size]
+	return items, EncodeToken(cursorOf(items[size-1]))
+}
@@ -0,0 +1,300 @@
+package pagination
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// DryRun 으로 SQL 만 만들기 때문에 실제로 호출되지 않는 연결
+type noConn struct{}
+
+var errNoConn = errors.New("no connection")
+
+func (noConn) PrepareContext(context.Context, string) (*sql.Stmt, error) { return nil, errNoConn }
+func (noConn) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, errNoConn
+}
+func (noConn) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, errNoConn
+}
+func (noConn) QueryRowContext(context.Context, string, ...interface{}) *sql.Row { return nil }
+
+type post struct {
+	ID        uint
+	CreatedAt time.Time
+}
+
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      noConn{},
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DryRun: true})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+
+	return gormDB
+}
+
+func TestTokenRoundTrip(t *testing.T) {
+	cursors := []Cursor{
+		{CreatedAt: time.Unix(0, 0), ID: 0},
+		{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), ID: 42},
+		{CreatedAt: time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), ID: 1},
+	}
+
+	for _, want := range cursors {
+		got, err := DecodeToken(EncodeToken(want))
+		if err != nil {
+			t.Fatalf("DecodeToken(EncodeToken(%v)) returned error: %v", want, err)
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+			t.Errorf("round trip = %v, want %v", *got, want)
+		}
+	}
+}
+
+func TestDecodeToken(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "empty token is first page", token: "", wantNil: true},
+		{name: "valid token", token: encode("1700000000000000000:7")},
+		{name: "not base64", token: "!!!", wantErr: true},
+		{name: "padded base64", token: base64.URLEncoding.EncodeToString([]byte("1:1")) + "=", wantErr: true},
+		{name: "missing id", token: encode("1700000000000000000"), wantErr: true},
+		{name: "extra part", token: encode("1:2:3"), wantErr: true},
+		{name: "non numeric time", token: encode("yesterday:7"), wantErr: true},
+		{name: "non numeric id", token: encode("1:seven"), wantErr: true},
+		{name: "negative id", token: encode("1:-7"), wantErr: true},
+		{name: "tampered token", token: EncodeToken(Cursor{CreatedAt: time.Unix(1, 0), ID: 1})[1:], wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeToken(tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPageToken) {
+					t.Fatalf("err = %v, want ErrInvalidPageToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (cursor == nil) != tt.wantNil {
+				t.Fatalf("cursor = %v, wantNil %v", cursor, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		requested uint32
+		want      int
+	}{
+		{0, DefaultPageSize},
+		{1, 1},
+		{MaxPageSize, MaxPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	}
+
+	for _, tt := range tests {
+		if got := PageSize(tt.requested); got != tt.want {
+			t.Errorf("PageSize(%d) = %d, want %d", tt.requested, got, tt.want)
+		}
+	}
+}
+
+func TestScopes(t *testing.T) {
+	cursor := &Cursor{CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ID: 10}
+
+	tests := []struct {
+		name     string
+		scope    func(*gorm.DB) *gorm.DB
+		want     []string
+		wantVars int
+	}{
+		{
+			name:  "first page",
+			scope: Scope("posts", nil, 20),
+			want:  []string{"ORDER BY posts.created_at desc,posts.id desc", "LIMIT 21"},
+		},
+		{
+			name:  "next page breaks ties on id",
+			scope: Scope("posts", cursor, 20),
+			want: []string{
+				"(posts.created_at < ? OR (posts.created_at = ? AND posts.id < ?))",
+				"ORDER BY posts.created_at desc,posts.id desc",
+			},
+			wantVars: 3,
+		},
+		{
+			name:  "custom column",
+			scope: ScopeBy("posts", "like_count", cursor, 5),
+			want: []string{
+				"(posts.like_count < ? OR (posts.like_count = ? AND posts.id < ?))",
+				"ORDER BY posts.like_count desc,posts.id desc",
+				"LIMIT 6",
+			},
+			wantVars: 3,
+		},
+		{
+			name:  "ascending",
+			scope: ScopeAsc("comments", cursor, 20),
+			want: []string{
+				"(comments.created_at > ? OR (comments.created_at = ? AND comments.id > ?))",
+				"ORDER BY comments.created_at asc,comments.id asc",
+			},
+			wantVars: 3,
+		},
+	}
+
+	gormDB := dryRunDB(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := gormDB.Table("posts").Scopes(tt.scope).Find(&[]post{}).Statement
+
+			query := stmt.SQL.String()
+			for _, want := range tt.want {
+				if !strings.Contains(query, want) {
+					t.Errorf("query %q does not contain %q", query, want)
+				}
+			}
+			if len(stmt.Vars) != tt.wantVars {
+				t.Errorf("len(vars) = %d, want %d", len(stmt.Vars), tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursorOf := func(p post) Cursor {
+		return Cursor{CreatedAt: p.CreatedAt, ID: p.ID}
+	}
+	posts := func(ids ...uint) []post {
+		items := make([]post, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, post{ID: id, CreatedAt: now})
+		}
+		return items
+	}
+
+	tests := []struct {
+		name      string
+		items     []post
+		size      int
+		wantLen   int
+		wantToken bool
+	}{
+		{name: "empty", items: nil, size: 2, wantLen: 0},
+		{name: "last page shorter than size", items: posts(3), size: 2, wantLen: 1},
+		{name: "last page exactly size", items: posts(3, 2), size: 2, wantLen: 2},
+		{name: "more pages", items: posts(3, 2, 1), size: 2, wantLen: 2, wantToken: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, token := Next(tt.items, tt.size, cursorOf)
+			if len(items) != tt.wantLen {
+				t.Errorf("len(items) = %d, want %d", len(items), tt.wantLen)
+			}
+			if (token != "") != tt.wantToken {
+				t.Fatalf("token = %q, wantToken %v", token, tt.wantToken)
+			}
+			if token == "" {
+				return
+			}
+
+			cursor, err := DecodeToken(token)
+			if err != nil {
+				t.Fatalf("DecodeToken returned error: %v", err)
+			}
+			if last := items[len(items)-1]; cursor.ID != last.ID || !cursor.CreatedAt.Equal(last.CreatedAt) {
+				t.Errorf("cursor = %v, want last item %v", *cursor, last)
+			}
+		})
+	}
+}
+
+// 같은 created_at 을 가진 행이 페이지 경계에 걸려도 빠지거나 겹치지 않아야 한다
+func TestPagingThroughTies(t *testing.T) {
+	tied := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []post{
+		{ID: 9, CreatedAt: tied.Add(time.Second)},
+		{ID: 8, CreatedAt: tied},
+		{ID: 7, CreatedAt: tied},
+		{ID: 6, CreatedAt: tied},
+		{ID: 5, CreatedAt: tied},
+		{ID: 4, CreatedAt: tied.Add(-time.Second)},
+	}
+	cursorOf := func(p post) Cursor {
+		return Cursor{CreatedAt: p.CreatedAt, ID: p.ID}
+	}
+
+	// Scope 의 WHERE 조건과 같은 방식으로 cursor 뒤의 행을 고른다
+	query := func(cursor *Cursor, size int) []post {
+		var page []post
+		for _, row := range rows {
+			if cursor != nil && !(row.CreatedAt.Before(cursor.CreatedAt) ||
+				(row.CreatedAt.Equal(cursor.CreatedAt) && row.ID < cursor.ID)) {
+				continue
+			}
+			if len(page) == size+1 {
+				break
+			}
+			page = append(page, row)
+		}
+		return page
+	}
+
+	var seen []uint
+	var cursor *Cursor
+	for pages := 0; ; pages++ {
+		if pages > len(rows) {
+			t.Fatal("paging did not terminate")
+		}
+
+		items, token := Next(query(cursor, 2), 2, cursorOf)
+		for _, item := range items {
+			seen = append(seen, item.ID)
+		}
+		if token == "" {
+			break
+		}
+
+		var err error
+		if cursor, err = DecodeToken(token); err != nil {
+			t.Fatalf("DecodeToken returned error: %v", err)
+		}
+	}
+
+	want := []uint{9, 8, 7, 6, 5, 4}
+	if len(seen) != len(want) {
+		t.Fatalf("seen = %v, want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("seen = %v, want %v", seen, want)
+		}
+	}
+}
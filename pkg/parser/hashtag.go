@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const MaxTagLength = 100
+
+// 단어 중간의 # (예: "C#", "a#b") 은 해시태그로 보지 않는다
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/])#([\p{L}\p{N}_]+)`)
+
+// ExtractHashtags 는 본문에 등장한 해시태그를 소문자로 정규화해 등장 순서대로 중복 없이 반환한다
+func ExtractHashtags(content string) []string {
+	seen := make(map[string]bool)
+	tags := make([]string, 0)
+
+	for _, match := range hashtagPattern.FindAllStringSubmatch(content, -1) {
+		tag := NormalizeTag(match[1])
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// NormalizeTag 는 검색어와 저장된 태그를 같은 형태로 맞춘다
+func NormalizeTag(tag string) string {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if utf8.RuneCountInString(tag) > MaxTagLength {
+		return ""
+	}
+
+	return tag
+}
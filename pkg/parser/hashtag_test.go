@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "empty", content: "", want: []string{}},
+		{name: "start of string", content: "#go is fun", want: []string{"go"}},
+		{name: "end of string", content: "learning #go", want: []string{"go"}},
+		{name: "only tag", content: "#go", want: []string{"go"}},
+		{name: "adjacent tags separated by space", content: "#go #grpc", want: []string{"go", "grpc"}},
+		{name: "after punctuation", content: "(#go), #grpc! \"#gorm\"", want: []string{"go", "grpc", "gorm"}},
+		{name: "after newline", content: "hello\n#go", want: []string{"go"}},
+		{name: "trailing punctuation is not part of tag", content: "#go, #grpc.", want: []string{"go", "grpc"}},
+		{name: "normalized to lower case", content: "#Go #GRPC", want: []string{"go", "grpc"}},
+		{name: "duplicates keep first occurrence", content: "#go #grpc #Go #go", want: []string{"go", "grpc"}},
+		{name: "unicode letters", content: "오늘은 #맛집 #카페투어", want: []string{"맛집", "카페투어"}},
+		{name: "digits and underscore", content: "#web_3 #2024", want: []string{"web_3", "2024"}},
+		{name: "inside word", content: "C# and a#b", want: []string{}},
+		{name: "url fragment", content: "https://example.com/page#section", want: []string{}},
+		{name: "html entity", content: "&#39; quoted", want: []string{}},
+		{name: "directly after another tag", content: "#go#grpc", want: []string{"go"}},
+		{name: "bare hash", content: "# not a tag ##", want: []string{}},
+		{name: "too long", content: "#" + strings.Repeat("a", MaxTagLength+1), want: []string{}},
+		{name: "max length", content: "#" + strings.Repeat("a", MaxTagLength), want: []string{strings.Repeat("a", MaxTagLength)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractHashtags(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractHashtags(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "go", want: "go"},
+		{tag: "#Go", want: "go"},
+		{tag: "  #GRPC  ", want: "grpc"},
+		{tag: "맛집", want: "맛집"},
+		{tag: "", want: ""},
+		{tag: strings.Repeat("가", MaxTagLength), want: strings.Repeat("가", MaxTagLength)},
+		{tag: strings.Repeat("가", MaxTagLength+1), want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeTag(tt.tag); got != tt.want {
+			t.Errorf("NormalizeTag(%q) = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// 이메일 주소의 @ 처럼 단어 중간에 있는 @ 는 멘션으로 보지 않는다
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([\p{L}\p{N}_.\-]+)`)
+
+// Mention 은 본문에서 @user_id 가 차지하는 위치
+// Offset, Length 는 rune 단위이며 Length 는 @ 를 포함한다
+type Mention struct {
+	Handle string
+	Offset int
+	Length int
+}
+
+func ExtractMentions(content string) []Mention {
+	mentions := make([]Mention, 0)
+
+	for _, loc := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := loc[2], loc[3]
+
+		// 문장 끝의 마침표나 하이픈은 아이디에 포함하지 않는다
+		handle := strings.TrimRight(content[start:end], ".-")
+		if handle == "" {
+			continue
+		}
+
+		mentions = append(mentions, Mention{
+			Handle: handle,
+			Offset: utf8.RuneCountInString(content[:start-1]),
+			Length: utf8.RuneCountInString(handle) + 1,
+		})
+	}
+
+	return mentions
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Mention
+	}{
+		{name: "empty", content: "", want: []Mention{}},
+		{name: "start of string", content: "@alice hi", want: []Mention{{Handle: "alice", Offset: 0, Length: 6}}},
+		{name: "end of string", content: "hi @alice", want: []Mention{{Handle: "alice", Offset: 3, Length: 6}}},
+		{
+			name:    "adjacent mentions separated by space",
+			content: "@alice @bob",
+			want: []Mention{
+				{Handle: "alice", Offset: 0, Length: 6},
+				{Handle: "bob", Offset: 7, Length: 4},
+			},
+		},
+		{
+			name:    "after punctuation",
+			content: "(@alice),@bob",
+			want: []Mention{
+				{Handle: "alice", Offset: 1, Length: 6},
+				{Handle: "bob", Offset: 9, Length: 4},
+			},
+		},
+		{name: "trailing period", content: "thanks @alice.", want: []Mention{{Handle: "alice", Offset: 7, Length: 6}}},
+		{name: "trailing hyphen", content: "@alice- hi", want: []Mention{{Handle: "alice", Offset: 0, Length: 6}}},
+		{name: "dots and hyphens inside handle", content: "@a.b-c_d", want: []Mention{{Handle: "a.b-c_d", Offset: 0, Length: 8}}},
+		{
+			name:    "duplicates keep every position",
+			content: "@bob and @bob",
+			want: []Mention{
+				{Handle: "bob", Offset: 0, Length: 4},
+				{Handle: "bob", Offset: 9, Length: 4},
+			},
+		},
+		{
+			name:    "offsets count runes",
+			content: "안녕 @철수 @bob",
+			want: []Mention{
+				{Handle: "철수", Offset: 3, Length: 3},
+				{Handle: "bob", Offset: 7, Length: 4},
+			},
+		},
+		{name: "email address", content: "mail me at alice@example.com", want: []Mention{}},
+		{name: "email like after mention", content: "@alice@example.com", want: []Mention{{Handle: "alice", Offset: 0, Length: 6}}},
+		{name: "double at", content: "@@alice", want: []Mention{}},
+		{name: "after dot", content: "x.@alice", want: []Mention{}},
+		{name: "bare at", content: "@ alone @.", want: []Mention{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractMentions(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractMentions(%q) = %+v, want %+v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package pubsub
+
+import "fmt"
+
+type Message struct {
+	Topic string
+	Data  []byte
+}
+
+// Broker 는 서버 인스턴스 사이에 이벤트를 전달하는 방식
+// 지금은 단일 프로세스용 MemoryBroker 만 있고, 여러 인스턴스로 늘어나면 외부 브로커 구현으로 교체한다
+type Broker interface {
+	Publish(topic string, data []byte) error
+	// 반환된 cancel 을 호출하면 구독이 해제되고 채널이 닫힌다
+	Subscribe(topics []string) (<-chan Message, func(), error)
+}
+
+// 사용자에게 전달되는 알림
+func UserTopic(userID uint) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// 사용자가 참여한 대화의 메시지와 읽음 표시
+func ChatTopic(userID uint) string {
+	return fmt.Sprintf("chat:%d", userID)
+}
+
+// 게시글에 새로 달린 댓글
+func PostTopic(postID uint) string {
+	return fmt.Sprintf("post:%d", postID)
+}
@@ -0,0 +1,73 @@
+package pubsub
+
+import (
+	"log"
+	"sync"
+)
+
+// 구독자가 받아가지 못한 메시지를 쌓아둘 수 있는 최대 개수, 넘치면 버린다
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	messages chan Message
+}
+
+// MemoryBroker 는 같은 프로세스 안의 구독자에게만 메시지를 전달하는 Broker
+type MemoryBroker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		subscribers: make(map[string]map[*subscriber]struct{}),
+	}
+}
+
+func (b *MemoryBroker) Publish(topic string, data []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers[topic] {
+		select {
+		case sub.messages <- Message{Topic: topic, Data: data}:
+		default:
+			log.Printf("pubsub: dropped message on %s for slow subscriber", topic)
+		}
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(topics []string) (<-chan Message, func(), error) {
+	sub := &subscriber{
+		messages: make(chan Message, subscriberBufferSize),
+	}
+
+	b.mu.Lock()
+	for _, topic := range topics {
+		if b.subscribers[topic] == nil {
+			b.subscribers[topic] = make(map[*subscriber]struct{})
+		}
+		b.subscribers[topic][sub] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			for _, topic := range topics {
+				delete(b.subscribers[topic], sub)
+				if len(b.subscribers[topic]) == 0 {
+					delete(b.subscribers, topic)
+				}
+			}
+			close(sub.messages)
+		})
+	}
+
+	return sub.messages, cancel, nil
+}
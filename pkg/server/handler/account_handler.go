@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// DownloadDataExport 가 한 번에 보내는 크기
+const exportChunkSize = 64 * 1024
+
+var dataExportStatuses = map[string]pb.DataExportStatus{
+	db.DataExportStatusPending:   pb.DataExportStatus_DATA_EXPORT_STATUS_PENDING,
+	db.DataExportStatusRunning:   pb.DataExportStatus_DATA_EXPORT_STATUS_RUNNING,
+	db.DataExportStatusCompleted: pb.DataExportStatus_DATA_EXPORT_STATUS_COMPLETED,
+	db.DataExportStatusFailed:    pb.DataExportStatus_DATA_EXPORT_STATUS_FAILED,
+}
+
+func (h *UserHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var user db.User
+	result := h.DB.Where("id = ?", userIDUint).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if !auth.CheckPasswordHash(req.GetPassword(), user.Password) {
+		return nil, status.Error(codes.PermissionDenied, "password is not correct")
+	}
+
+	// 첨부 파일은 행을 지운 뒤에는 찾을 수 없으므로 key 를 먼저 모아 둔다
+	var mediaKeys []string
+	h.DB.Model(&db.Media{}).Where("user_id = ?", user.ID).Pluck("storage_key", &mediaKeys)
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		return deleteAccount(tx, user.ID)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete account")
+	}
+
+	if err := h.Exporter.DeleteAll(user.ID); err != nil {
+		log.Printf("failed to delete data exports of user %d: %v", user.ID, err)
+	}
+
+	for _, key := range mediaKeys {
+		if err := h.Storage.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete media %s of user %d: %v", key, user.ID, err)
+		}
+	}
+
+	return &pb.DeleteAccountResponse{}, nil
+}
+
+// 게시글은 함께 지우고 댓글과 메시지는 남겨 대화의 흐름이 끊기지 않게 한다
+// 사용자 행은 개인 정보를 지운 뒤 soft delete 하므로 남은 댓글과 메시지의 작성자는 비어 보인다
+func deleteAccount(tx *gorm.DB, userID uint) error {
+	likedPostIDs := tx.Session(&gorm.Session{NewDB: true}).
+		Model(&db.PostLike{}).Select("post_id").Where("user_id = ?", userID)
+	err := tx.Model(&db.Post{}).
+		Where("id IN (?) AND like_count > 0", likedPostIDs).
+		UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
+	if err != nil {
+		return err
+	}
+
+	likedCommentIDs := tx.Session(&gorm.Session{NewDB: true}).
+		Model(&db.CommentLike{}).Select("comment_id").Where("user_id = ?", userID)
+	err = tx.Model(&db.Comment{}).
+		Where("id IN (?) AND like_count > 0", likedCommentIDs).
+		UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.PostLike{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.CommentLike{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("follower_id = ? OR following_id = ?", userID, userID).Delete(&db.Follow{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.TimelineEntry{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ? OR actor_id = ?", userID, userID).Delete(&db.Notification{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.Mention{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.ConversationMember{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("blocker_id = ? OR blocked_id = ?", userID, userID).Delete(&db.Block{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("muter_id = ? OR muted_id = ?", userID, userID).Delete(&db.Mute{}).Error; err != nil {
+		return err
+	}
+
+	err = tx.Where("reporter_id = ? OR (target_type = ? AND target_id = ? AND status = ?)",
+		userID, db.ReportTargetUser, userID, db.ReportStatusOpen).Delete(&db.Report{}).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.Post{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&db.Media{}).Error; err != nil {
+		return err
+	}
+
+	var sessionIDs []uint
+	err = tx.Model(&db.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Pluck("id", &sessionIDs).Error
+	if err != nil {
+		return err
+	}
+
+	if err := revokeSessions(tx, sessionIDs); err != nil {
+		return err
+	}
+
+	// 탈퇴한 user_id 와 email 은 다른 사용자가 다시 쓸 수 있다
+	err = tx.Model(&db.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"user_id":   fmt.Sprintf("deleted-%d", userID),
+		"password":  "",
+		"name":      "",
+		"age":       0,
+		"sex":       "",
+		"birthday":  nil,
+		"introduce": "",
+		"email":     nil,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Delete(&db.User{}, userID).Error
+}
+
+func (h *UserHandler) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// 진행 중인 작업이 있으면 새로 만들지 않고 그 작업을 돌려준다
+	var export db.DataExport
+	result := h.DB.Where("user_id = ? AND status IN ?", userIDUint,
+		[]string{db.DataExportStatusPending, db.DataExportStatusRunning}).
+		First(&export)
+	if result.Error == nil {
+		return &pb.ExportMyDataResponse{
+			Export: toDataExportMessage(export),
+		}, nil
+	}
+
+	export = db.DataExport{
+		UserID: uint(userIDUint),
+		Status: db.DataExportStatusPending,
+	}
+	if err := h.DB.Create(&export).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export data")
+	}
+
+	if err := h.Exporter.Enqueue(export.ID); err != nil {
+		return nil, status.Error(codes.ResourceExhausted, "too many exports are in progress, try again later")
+	}
+
+	return &pb.ExportMyDataResponse{
+		Export: toDataExportMessage(export),
+	}, nil
+}
+
+func (h *UserHandler) GetDataExport(ctx context.Context, req *pb.GetDataExportRequest) (*pb.GetDataExportResponse, error) {
+	export, err := h.findDataExport(ctx, req.GetExportId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDataExportResponse{
+		Export: toDataExportMessage(export),
+	}, nil
+}
+
+func (h *UserHandler) DownloadDataExport(req *pb.DownloadDataExportRequest, stream pb.UserService_DownloadDataExportServer) error {
+	export, err := h.findDataExport(stream.Context(), req.GetExportId())
+	if err != nil {
+		return err
+	}
+
+	if export.Status != db.DataExportStatusCompleted {
+		return status.Error(codes.FailedPrecondition, "export is not completed")
+	}
+
+	// 만료된 파일은 작업자가 아직 지우지 않았더라도 내려주지 않는다
+	if export.ExpiresAt != nil && time.Now().After(*export.ExpiresAt) {
+		return status.Error(codes.FailedPrecondition, "export is expired")
+	}
+
+	file, err := os.Open(export.FilePath)
+	if err != nil {
+		return status.Error(codes.NotFound, "export file is not exists")
+	}
+	defer file.Close()
+
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.DataExportChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, "failed to read export file")
+		}
+	}
+}
+
+func (h *UserHandler) findDataExport(ctx context.Context, exportID uint32) (db.DataExport, error) {
+	var export db.DataExport
+
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return export, err
+	}
+
+	result := h.DB.Where("id = ? AND user_id = ?", exportID, userID).First(&export)
+	if result.Error != nil {
+		return export, status.Error(codes.NotFound, "export is not exists")
+	}
+
+	return export, nil
+}
+
+func toDataExportMessage(export db.DataExport) *pb.DataExport {
+	var completedAt, expiresAt *timestamppb.Timestamp
+	if export.CompletedAt != nil {
+		completedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+
+	return &pb.DataExport{
+		Id:          uint32(export.ID),
+		Status:      dataExportStatuses[export.Status],
+		Size:        export.Size,
+		CreatedAt:   timestamppb.New(export.CreatedAt),
+		CompletedAt: completedAt,
+		ExpiresAt:   expiresAt,
+	}
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (h *UserHandler) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if target.ID == uint(userIDUint) {
+		return nil, status.Error(codes.InvalidArgument, "you can't block yourself")
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		block := db.Block{
+			BlockerID: uint(userIDUint),
+			BlockedID: target.ID,
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&block)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.AlreadyExists, "you already block this user")
+		}
+
+		// 차단하면 양쪽 팔로우를 모두 끊는다
+		follows := [][2]uint{{block.BlockerID, block.BlockedID}, {block.BlockedID, block.BlockerID}}
+		for _, follow := range follows {
+			result := tx.Where("follower_id = ? AND following_id = ?", follow[0], follow[1]).Delete(&db.Follow{})
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+				continue
+			}
+
+			if err := h.Timeline.Unfollowed(tx, follow[0], follow[1]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to block user")
+	}
+
+	return &pb.BlockUserResponse{
+		Message: fmt.Sprintf("you blocked %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	result = h.DB.Where("blocker_id = ? AND blocked_id = ?", userID, target.ID).Delete(&db.Block{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to unblock user")
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "you don't block this user")
+	}
+
+	return &pb.UnblockUserResponse{
+		Message: fmt.Sprintf("you unblocked %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListUsersResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var blocks []db.Block
+	result := h.DB.Scopes(pagination.Scope("blocks", cursor, size)).
+		Where("blocker_id = ?", userID).
+		Preload("Blocked").
+		Find(&blocks)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get blocked users")
+	}
+
+	blocks, nextPageToken := pagination.Next(blocks, size, func(block db.Block) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: block.CreatedAt, ID: block.ID}
+	})
+
+	var users []*pb.UserSummary
+	for _, block := range blocks {
+		users = append(users, toUserSummary(block.Blocked))
+	}
+
+	return &pb.ListUsersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *UserHandler) MuteUser(ctx context.Context, req *pb.MuteUserRequest) (*pb.MuteUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if target.ID == uint(userIDUint) {
+		return nil, status.Error(codes.InvalidArgument, "you can't mute yourself")
+	}
+
+	mute := db.Mute{
+		MuterID: uint(userIDUint),
+		MutedID: target.ID,
+	}
+
+	result = h.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&mute)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to mute user")
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.AlreadyExists, "you already mute this user")
+	}
+
+	return &pb.MuteUserResponse{
+		Message: fmt.Sprintf("you muted %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) UnmuteUser(ctx context.Context, req *pb.UnmuteUserRequest) (*pb.UnmuteUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	result = h.DB.Where("muter_id = ? AND muted_id = ?", userID, target.ID).Delete(&db.Mute{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to unmute user")
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "you don't mute this user")
+	}
+
+	return &pb.UnmuteUserResponse{
+		Message: fmt.Sprintf("you unmuted %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) ListMutedUsers(ctx context.Context, req *pb.ListMutedUsersRequest) (*pb.ListUsersResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var mutes []db.Mute
+	result := h.DB.Scopes(pagination.Scope("mutes", cursor, size)).
+		Where("muter_id = ?", userID).
+		Preload("Muted").
+		Find(&mutes)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get muted users")
+	}
+
+	mutes, nextPageToken := pagination.Next(mutes, size, func(mute db.Mute) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: mute.CreatedAt, ID: mute.ID}
+	})
+
+	var users []*pb.UserSummary
+	for _, mute := range mutes {
+		users = append(users, toUserSummary(mute.Muted))
+	}
+
+	return &pb.ListUsersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -4,29 +4,191 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/comment"
 	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
 	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// db.Comment 의 컬럼 크기
+const maxCommentLength = 500
+
 type CommentHandler struct {
 	pb.UnimplementedCommentServiceServer
-	DB  *gorm.DB
-	JWT *auth.JWT
+	DB     *gorm.DB
+	JWT    *auth.JWT
+	Broker pubsub.Broker
 }
 
-func NewCommentHandler(db *gorm.DB, jwt *auth.JWT) *CommentHandler {
+func NewCommentHandler(db *gorm.DB, jwt *auth.JWT, broker pubsub.Broker) *CommentHandler {
 	return &CommentHandler{
-		DB:  db,
-		JWT: jwt,
+		DB:     db,
+		JWT:    jwt,
+		Broker: broker,
 	}
 }
 
+func (h *CommentHandler) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	post, err := findVisiblePost(h.DB, viewerID, uint(req.GetPostId()))
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []db.Comment
+	result := h.DB.Scopes(visibleComments(viewerID), pagination.Scope("comments", cursor, size)).
+		Where("post_id = ? AND parent_comment_id IS NULL", post.ID).
+		Preload("User").
+		Preload("Mentions.User").
+		Find(&comments)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get comments")
+	}
+
+	comments, nextPageToken := pagination.Next(comments, size, commentCursor)
+	replyCounts := h.replyCounts(viewerID, comments)
+
+	var pbComments []*pb.Comment
+	for _, comment := range comments {
+		pbComments = append(pbComments, &pb.Comment{
+			Id:         uint32(comment.ID),
+			PostId:     uint32(comment.PostID),
+			UserName:   comment.User.Name,
+			Content:    comment.Content,
+			CreatedAt:  timestamppb.New(comment.CreatedAt),
+			UpdatedAt:  timestamppb.New(comment.UpdatedAt),
+			LikeCount:  uint32(comment.LikeCount),
+			ReplyCount: uint32(replyCounts[comment.ID]),
+			Mentions:   toCommentMentions(comment.Mentions),
+		})
+	}
+
+	return &pb.ListCommentsResponse{
+		Comments:      pbComments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *CommentHandler) ListReplies(ctx context.Context, req *pb.ListRepliesRequest) (*pb.ListRepliesResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var parentComment db.Comment
+	result := h.DB.Scopes(notHidden("comments")).First(&parentComment, req.GetParentCommentId())
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "comment is not exists")
+	}
+
+	if _, err := findVisiblePost(h.DB, viewerID, parentComment.PostID); err != nil {
+		return nil, status.Error(codes.NotFound, "comment is not exists")
+	}
+
+	var replies []db.Comment
+	result = h.DB.Scopes(visibleComments(viewerID), pagination.ScopeAsc("comments", cursor, size)).
+		Where("parent_comment_id = ?", parentComment.ID).
+		Preload("User").
+		Preload("Mentions.User").
+		Find(&replies)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get replies")
+	}
+
+	replies, nextPageToken := pagination.Next(replies, size, commentCursor)
+
+	var pbReplies []*pb.Comment
+	for _, reply := range replies {
+		pbReplies = append(pbReplies, &pb.Comment{
+			Id:              uint32(reply.ID),
+			PostId:          uint32(reply.PostID),
+			UserName:        reply.User.Name,
+			Content:         reply.Content,
+			CreatedAt:       timestamppb.New(reply.CreatedAt),
+			UpdatedAt:       timestamppb.New(reply.UpdatedAt),
+			LikeCount:       uint32(reply.LikeCount),
+			ParentCommentId: uint32(parentComment.ID),
+			Mentions:        toCommentMentions(reply.Mentions),
+		})
+	}
+
+	return &pb.ListRepliesResponse{
+		Replies:       pbReplies,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func commentCursor(comment db.Comment) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
+
+// 최상위 댓글별 대댓글 수를 한 번의 쿼리로 센다
+func (h *CommentHandler) replyCounts(viewerID uint, comments []db.Comment) map[uint]int64 {
+	counts := make(map[uint]int64)
+	if len(comments) == 0 {
+		return counts
+	}
+
+	commentIDs := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+
+	var rows []struct {
+		ParentCommentID uint
+		Count           int64
+	}
+	h.DB.Model(&db.Comment{}).
+		Scopes(visibleComments(viewerID)).
+		Select("parent_comment_id, COUNT(*) AS count").
+		Where("parent_comment_id IN ?", commentIDs).
+		Group("parent_comment_id").
+		Scan(&rows)
+
+	for _, row := range rows {
+		counts[row.ParentCommentID] = row.Count
+	}
+
+	return counts
+}
+
+func validateCommentContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return status.Error(codes.InvalidArgument, "content can't be empty")
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxCommentLength)
+	}
+	return nil
+}
+
 func (h *CommentHandler) WriteComment(ctx context.Context, req *pb.WriteCommentRequest) (*pb.WriteCommentResponse, error) {
 	userID, err := ExtractUserIDFromContext(ctx)
 	if err != nil {
@@ -38,6 +200,10 @@ func (h *CommentHandler) WriteComment(ctx context.Context, req *pb.WriteCommentR
 		return nil, err
 	}
 
+	if err := validateCommentContent(req.GetContent()); err != nil {
+		return nil, err
+	}
+
 	comment := db.Comment{
 		UserID:  uint(userIDUint),
 		PostID:  uint(req.GetPostId()),
@@ -50,11 +216,42 @@ func (h *CommentHandler) WriteComment(ctx context.Context, req *pb.WriteCommentR
 		return nil, status.Error(codes.NotFound, "user is not exists")
 	}
 
-	result := h.DB.Create(&comment)
-	if result.Error != nil {
+	var mentions []db.Mention
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		post, err := findVisiblePost(tx, uint(userIDUint), comment.PostID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Create(&comment).Error; err != nil {
+			return err
+		}
+
+		err = createNotification(tx, db.Notification{
+			UserID:    post.UserID,
+			ActorID:   comment.UserID,
+			Type:      db.NotificationTypeComment,
+			PostID:    &post.ID,
+			CommentID: &comment.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		mentions, err = syncMentions(tx, comment.UserID, comment.Content, comment.PostID, &comment.ID)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, "failed to write comment")
 	}
 
+	publishOutbox(h.DB, h.Broker, box)
+	publishComment(h.Broker, comment, user.Name)
+
 	return &pb.WriteCommentResponse{
 		Comment: &pb.Comment{
 			Id:        uint32(comment.ID),
@@ -63,6 +260,8 @@ func (h *CommentHandler) WriteComment(ctx context.Context, req *pb.WriteCommentR
 			Content:   comment.Content,
 			CreatedAt: timestamppb.New(comment.CreatedAt),
 			UpdatedAt: timestamppb.New(comment.UpdatedAt),
+			LikeCount: uint32(comment.LikeCount),
+			Mentions:  toCommentMentions(mentions),
 		},
 	}, nil
 }
@@ -78,21 +277,36 @@ func (h *CommentHandler) WriteReply(ctx context.Context, req *pb.WriteReplyReque
 		return nil, err
 	}
 
-	parentComment := db.Comment{}
-	h.DB.First(&parentComment, req.GetParentCommentId())
+	if err := validateCommentContent(req.GetContent()); err != nil {
+		return nil, err
+	}
+
+	var parentComment db.Comment
+	result := h.DB.Scopes(notHidden("comments")).First(&parentComment, req.GetParentCommentId())
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "comment is not exists")
+	}
 
 	// 부모 댓글이 이미 대댓글인 경우 에러를 반환
 	if parentComment.ParentCommentID != nil {
 		return nil, status.Error(codes.InvalidArgument, "nested replies are not allowed")
 	}
 
+	// 대댓글은 항상 부모 댓글이 달린 게시글에 속한다
+	if req.GetPostId() != 0 && uint(req.GetPostId()) != parentComment.PostID {
+		return nil, status.Error(codes.InvalidArgument, "post_id does not match the parent comment")
+	}
+
+	if isBlocked(h.DB, uint(userIDUint), parentComment.UserID) {
+		return nil, status.Error(codes.PermissionDenied, "you can't reply to this comment")
+	}
+
 	parentCommentID := uint(req.GetParentCommentId())
 	reply := db.Comment{
 		UserID:          uint(userIDUint),
-		PostID:          uint(req.GetPostId()),
+		PostID:          parentComment.PostID,
 		ParentCommentID: &parentCommentID,
 		Content:         req.GetContent(),
-		ParentComment:   &parentComment,
 	}
 
 	user := db.User{}
@@ -101,19 +315,67 @@ func (h *CommentHandler) WriteReply(ctx context.Context, req *pb.WriteReplyReque
 		return nil, status.Error(codes.NotFound, "user is not exists")
 	}
 
-	result := h.DB.Create(&reply)
-	if result.Error != nil {
+	var mentions []db.Mention
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		post, err := findVisiblePost(tx, uint(userIDUint), reply.PostID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Create(&reply).Error; err != nil {
+			return err
+		}
+
+		err = createNotification(tx, db.Notification{
+			UserID:    parentComment.UserID,
+			ActorID:   reply.UserID,
+			Type:      db.NotificationTypeReply,
+			PostID:    &post.ID,
+			CommentID: &reply.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 게시글 작성자가 부모 댓글 작성자와 다르면 게시글에 달린 댓글로 알린다
+		if post.UserID != parentComment.UserID {
+			err = createNotification(tx, db.Notification{
+				UserID:    post.UserID,
+				ActorID:   reply.UserID,
+				Type:      db.NotificationTypeComment,
+				PostID:    &post.ID,
+				CommentID: &reply.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		mentions, err = syncMentions(tx, reply.UserID, reply.Content, reply.PostID, &reply.ID)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, "failed to write reply")
 	}
 
+	publishOutbox(h.DB, h.Broker, box)
+	publishComment(h.Broker, reply, user.Name)
+
 	return &pb.WriteReplyResponse{
 		Reply: &pb.Comment{
-			Id:        uint32(reply.ID),
-			PostId:    uint32(reply.PostID),
-			UserName:  user.Name,
-			Content:   reply.Content,
-			CreatedAt: timestamppb.New(reply.CreatedAt),
-			UpdatedAt: timestamppb.New(reply.UpdatedAt),
+			Id:              uint32(reply.ID),
+			PostId:          uint32(reply.PostID),
+			UserName:        user.Name,
+			Content:         reply.Content,
+			CreatedAt:       timestamppb.New(reply.CreatedAt),
+			UpdatedAt:       timestamppb.New(reply.UpdatedAt),
+			LikeCount:       uint32(reply.LikeCount),
+			ParentCommentId: uint32(parentCommentID),
+			Mentions:        toCommentMentions(mentions),
 		},
 	}, nil
 }
@@ -129,35 +391,62 @@ func (h *CommentHandler) UpdateComment(ctx context.Context, req *pb.UpdateCommen
 		return nil, err
 	}
 
+	if err := validateCommentContent(req.GetContent()); err != nil {
+		return nil, err
+	}
+
 	var comment db.Comment
-	result := h.DB.Where("id = ?", req.GetCommentId()).First(&comment)
+	result := h.DB.Scopes(notHidden("comments")).Where("id = ?", req.GetCommentId()).First(&comment)
 	if result.Error != nil {
 		return nil, status.Error(codes.NotFound, "comment is not exists")
 	}
 
-	if comment.UserID != uint(userIDUint) {
+	if !canModify(ctx, comment.UserID, uint(userIDUint)) {
 		return nil, status.Error(codes.PermissionDenied, "you don't have permission")
 	}
 
-	user := h.DB.First(&db.User{}, comment.UserID)
-	if user.Error != nil {
+	var post db.Post
+	if err := h.DB.Scopes(notHidden("posts")).First(&post, comment.PostID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "post is not exists")
+	}
+
+	var user db.User
+	if err := h.DB.First(&user, comment.UserID).Error; err != nil {
 		return nil, status.Error(codes.NotFound, "user is not exists")
 	}
 
 	comment.Content = req.GetContent()
-	result = h.DB.Save(&comment)
-	if result.Error != nil {
+
+	var mentions []db.Mention
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&comment).Error; err != nil {
+			return err
+		}
+
+		mentions, err = syncMentions(tx, comment.UserID, comment.Content, comment.PostID, &comment.ID)
+		if err != nil {
+			return err
+		}
+
+		return recordModeratorEdit(tx, comment.UserID, uint(userIDUint), db.ModerationActionEditComment, db.ReportTargetComment, comment.ID)
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to update comment")
 	}
 
+	publishOutbox(h.DB, h.Broker, box)
+
 	return &pb.UpdateCommentResponse{
 		Comment: &pb.Comment{
 			Id:        uint32(comment.ID),
 			PostId:    uint32(comment.PostID),
 			Content:   comment.Content,
-			UserName:  user.Name(),
+			UserName:  user.Name,
 			CreatedAt: timestamppb.New(comment.CreatedAt),
 			UpdatedAt: timestamppb.New(comment.UpdatedAt),
+			LikeCount: uint32(comment.LikeCount),
+			Mentions:  toCommentMentions(mentions),
 		},
 	}, nil
 }
@@ -179,12 +468,18 @@ func (h *CommentHandler) DeleteComment(ctx context.Context, req *pb.DeleteCommen
 		return nil, status.Error(codes.NotFound, "comment is not exists")
 	}
 
-	if comment.UserID != uint(userIDUint) {
+	if !canModify(ctx, comment.UserID, uint(userIDUint)) {
 		return nil, status.Error(codes.PermissionDenied, "you don't have permission")
 	}
 
-	result = h.DB.Delete(&comment)
-	if result.Error != nil {
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&comment).Error; err != nil {
+			return err
+		}
+
+		return recordModeratorEdit(tx, comment.UserID, uint(userIDUint), db.ModerationActionDeleteComment, db.ReportTargetComment, comment.ID)
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to delete comment")
 	}
 
@@ -192,3 +487,126 @@ func (h *CommentHandler) DeleteComment(ctx context.Context, req *pb.DeleteCommen
 		Message: fmt.Sprintf("comment %d is deleted", comment.ID),
 	}, nil
 }
+
+func (h *CommentHandler) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment db.Comment
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(notHidden("comments")).First(&comment, req.GetCommentId()).Error; err != nil {
+			return status.Error(codes.NotFound, "comment is not exists")
+		}
+
+		if _, err := findVisiblePost(tx, uint(userIDUint), comment.PostID); err != nil {
+			return status.Error(codes.NotFound, "comment is not exists")
+		}
+
+		if isBlocked(tx, uint(userIDUint), comment.UserID) {
+			return status.Error(codes.PermissionDenied, "you can't like this comment")
+		}
+
+		like := db.CommentLike{
+			UserID:    uint(userIDUint),
+			CommentID: comment.ID,
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&like)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.AlreadyExists, "you already like this comment")
+		}
+
+		result = tx.Model(&comment).UpdateColumn("like_count", gorm.Expr("like_count + ?", 1))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		err := createNotification(tx, db.Notification{
+			UserID:    comment.UserID,
+			ActorID:   like.UserID,
+			Type:      db.NotificationTypeCommentLike,
+			PostID:    &comment.PostID,
+			CommentID: &comment.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.First(&comment, comment.ID).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to like comment")
+	}
+
+	publishOutbox(h.DB, h.Broker, box)
+
+	return &pb.LikeCommentResponse{
+		LikeCount: uint32(comment.LikeCount),
+	}, nil
+}
+
+func (h *CommentHandler) UnlikeComment(ctx context.Context, req *pb.UnlikeCommentRequest) (*pb.UnlikeCommentResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment db.Comment
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(notHidden("comments")).First(&comment, req.GetCommentId()).Error; err != nil {
+			return status.Error(codes.NotFound, "comment is not exists")
+		}
+
+		if _, err := findVisiblePost(tx, uint(userIDUint), comment.PostID); err != nil {
+			return status.Error(codes.NotFound, "comment is not exists")
+		}
+
+		result := tx.Where("user_id = ? AND comment_id = ?", userIDUint, comment.ID).Delete(&db.CommentLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.NotFound, "you don't like this comment")
+		}
+
+		result = tx.Model(&comment).
+			Where("like_count > 0").
+			UpdateColumn("like_count", gorm.Expr("like_count - ?", 1))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.First(&comment, comment.ID).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to unlike comment")
+	}
+
+	return &pb.UnlikeCommentResponse{
+		LikeCount: uint32(comment.LikeCount),
+	}, nil
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/notification"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type outboxKey struct{}
+
+// outbox 는 트랜잭션 안에서 만든 알림을 모아 두었다가 커밋된 뒤에 발행한다
+// 롤백된 쓰기 작업의 알림이 구독자에게 전달되지 않도록 하기 위함
+type outbox struct {
+	notifications []db.Notification
+}
+
+// 반환된 ctx 를 h.DB.WithContext 로 트랜잭션에 넘겨야 createNotification 이 outbox 에 기록한다
+func withOutbox(ctx context.Context) (context.Context, *outbox) {
+	box := &outbox{}
+	return context.WithValue(ctx, outboxKey{}, box), box
+}
+
+func outboxFrom(tx *gorm.DB) *outbox {
+	if tx.Statement.Context == nil {
+		return nil
+	}
+
+	box, _ := tx.Statement.Context.Value(outboxKey{}).(*outbox)
+	return box
+}
+
+// 발행에 실패해도 알림은 이미 저장되어 있으므로 로그만 남긴다
+func publishOutbox(gormDB *gorm.DB, broker pubsub.Broker, box *outbox) {
+	if len(box.notifications) == 0 {
+		return
+	}
+
+	actorIDs := make([]uint, 0, len(box.notifications))
+	for _, notification := range box.notifications {
+		actorIDs = append(actorIDs, notification.ActorID)
+	}
+
+	var actors []db.User
+	gormDB.Where("id IN ?", actorIDs).Find(&actors)
+
+	actorsByID := make(map[uint]db.User)
+	for _, actor := range actors {
+		actorsByID[actor.ID] = actor
+	}
+
+	for _, notification := range box.notifications {
+		notification.Actor = actorsByID[notification.ActorID]
+
+		publishEvent(broker, pubsub.UserTopic(notification.UserID), &pb.Event{
+			Event: &pb.Event_Notification{
+				Notification: toNotificationMessage(notification),
+			},
+		})
+	}
+}
+
+func publishComment(broker pubsub.Broker, comment db.Comment, userName string) {
+	var parentCommentID uint32
+	if comment.ParentCommentID != nil {
+		parentCommentID = uint32(*comment.ParentCommentID)
+	}
+
+	publishEvent(broker, pubsub.PostTopic(comment.PostID), &pb.Event{
+		Event: &pb.Event_Comment{
+			Comment: &pb.CommentEvent{
+				PostId:          uint32(comment.PostID),
+				CommentId:       uint32(comment.ID),
+				ParentCommentId: parentCommentID,
+				UserId:          uint32(comment.UserID),
+				UserName:        userName,
+				Content:         comment.Content,
+				CreatedAt:       timestamppb.New(comment.CreatedAt),
+			},
+		},
+	})
+}
+
+func publishEvent(broker pubsub.Broker, topic string, event *pb.Event) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal event for %s: %v", topic, err)
+		return
+	}
+
+	if err := broker.Publish(topic, data); err != nil {
+		log.Printf("failed to publish event to %s: %v", topic, err)
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// update_mask 를 검사하고 중복을 제거한 경로를 반환한다
+// allowed 에 없는 필드를 바꾸려고 하면 InvalidArgument 를 반환한다
+func updateMaskPaths(mask *fieldmaskpb.FieldMask, message proto.Message, allowed ...string) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+
+	if !mask.IsValid(message) {
+		return nil, status.Error(codes.InvalidArgument, "update_mask contains unknown fields")
+	}
+
+	allowedPaths := make(map[string]bool, len(allowed))
+	for _, path := range allowed {
+		allowedPaths[path] = true
+	}
+
+	mask = proto.Clone(mask).(*fieldmaskpb.FieldMask)
+	mask.Normalize()
+	for _, path := range mask.GetPaths() {
+		if !allowedPaths[path] {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s can't be updated", path)
+		}
+	}
+
+	return mask.GetPaths(), nil
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (h *UserHandler) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.FollowUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if target.ID == uint(userIDUint) {
+		return nil, status.Error(codes.InvalidArgument, "you can't follow yourself")
+	}
+
+	if isBlocked(h.DB, uint(userIDUint), target.ID) {
+		return nil, status.Error(codes.PermissionDenied, "you can't follow this user")
+	}
+
+	follow := db.Follow{
+		FollowerID:  uint(userIDUint),
+		FollowingID: target.ID,
+	}
+
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 동시에 같은 요청이 와도 unique 인덱스에 걸리지 않고 AlreadyExists 로 응답한다
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.AlreadyExists, "you already follow this user")
+		}
+
+		err := createNotification(tx, db.Notification{
+			UserID:  follow.FollowingID,
+			ActorID: follow.FollowerID,
+			Type:    db.NotificationTypeFollow,
+		})
+		if err != nil {
+			return err
+		}
+
+		return h.Timeline.Followed(tx, follow.FollowerID, follow.FollowingID)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to follow user")
+	}
+
+	publishOutbox(h.DB, h.Broker, box)
+
+	return &pb.FollowUserResponse{
+		Message: fmt.Sprintf("you are following %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*pb.UnfollowUserResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var target db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&target)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("follower_id = ? AND following_id = ?", userIDUint, target.ID).
+			Delete(&db.Follow{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.NotFound, "you don't follow this user")
+		}
+
+		return h.Timeline.Unfollowed(tx, uint(userIDUint), target.ID)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to unfollow user")
+	}
+
+	return &pb.UnfollowUserResponse{
+		Message: fmt.Sprintf("you unfollowed %s", target.UserId),
+	}, nil
+}
+
+func (h *UserHandler) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.ListFollowsResponse, error) {
+	target, err := h.findUserOrCaller(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var total int64
+	h.DB.Model(&db.Follow{}).Where("following_id = ?", target.ID).Count(&total)
+
+	var follows []db.Follow
+	result := h.DB.Scopes(pagination.Scope("follows", cursor, size)).
+		Where("following_id = ?", target.ID).
+		Preload("Follower").
+		Find(&follows)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get followers")
+	}
+
+	follows, nextPageToken := pagination.Next(follows, size, followCursor)
+
+	var users []*pb.UserSummary
+	for _, follow := range follows {
+		users = append(users, toUserSummary(follow.Follower))
+	}
+
+	return &pb.ListFollowsResponse{
+		Users:         users,
+		TotalCount:    uint32(total),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *UserHandler) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ListFollowsResponse, error) {
+	target, err := h.findUserOrCaller(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var total int64
+	h.DB.Model(&db.Follow{}).Where("follower_id = ?", target.ID).Count(&total)
+
+	var follows []db.Follow
+	result := h.DB.Scopes(pagination.Scope("follows", cursor, size)).
+		Where("follower_id = ?", target.ID).
+		Preload("Following").
+		Find(&follows)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get following")
+	}
+
+	follows, nextPageToken := pagination.Next(follows, size, followCursor)
+
+	var users []*pb.UserSummary
+	for _, follow := range follows {
+		users = append(users, toUserSummary(follow.Following))
+	}
+
+	return &pb.ListFollowsResponse{
+		Users:         users,
+		TotalCount:    uint32(total),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// user_id 가 비어 있으면 요청한 사용자 본인을 대상으로 한다
+func (h *UserHandler) findUserOrCaller(ctx context.Context, userID string) (db.User, error) {
+	var user db.User
+	if userID == "" {
+		callerID, err := ExtractUserIDFromContext(ctx)
+		if err != nil {
+			return user, err
+		}
+
+		result := h.DB.Where("id = ?", callerID).First(&user)
+		if result.Error != nil {
+			return user, status.Error(codes.NotFound, "user is not exists")
+		}
+
+		return user, nil
+	}
+
+	result := h.DB.Where("user_id = ?", userID).First(&user)
+	if result.Error != nil {
+		return user, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	return user, nil
+}
+
+func followCursor(follow db.Follow) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: follow.CreatedAt, ID: follow.ID}
+}
+
+func toUserSummary(user db.User) *pb.UserSummary {
+	return &pb.UserSummary{
+		UserId:    user.UserId,
+		Name:      user.Name,
+		Introduce: user.Introduce,
+	}
+}
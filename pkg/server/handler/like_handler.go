@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (h *PostHandler) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.LikePostResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var post db.Post
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		post, err = findVisiblePost(tx, uint(userIDUint), uint(req.GetId()))
+		if err != nil {
+			return err
+		}
+
+		like := db.PostLike{
+			UserID: uint(userIDUint),
+			PostID: post.ID,
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&like)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.AlreadyExists, "you already like this post")
+		}
+
+		result = tx.Model(&post).UpdateColumn("like_count", gorm.Expr("like_count + ?", 1))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		err = createNotification(tx, db.Notification{
+			UserID:  post.UserID,
+			ActorID: like.UserID,
+			Type:    db.NotificationTypePostLike,
+			PostID:  &post.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.First(&post, post.ID).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to like post")
+	}
+
+	publishOutbox(h.DB, h.Broker, box)
+
+	return &pb.LikePostResponse{
+		LikeCount: uint32(post.LikeCount),
+	}, nil
+}
+
+func (h *PostHandler) UnlikePost(ctx context.Context, req *pb.UnlikePostRequest) (*pb.UnlikePostResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var post db.Post
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		post, err = findVisiblePost(tx, uint(userIDUint), uint(req.GetId()))
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("user_id = ? AND post_id = ?", userIDUint, post.ID).Delete(&db.PostLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.NotFound, "you don't like this post")
+		}
+
+		result = tx.Model(&post).
+			Where("like_count > 0").
+			UpdateColumn("like_count", gorm.Expr("like_count - ?", 1))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.First(&post, post.ID).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to unlike post")
+	}
+
+	return &pb.UnlikePostResponse{
+		LikeCount: uint32(post.LikeCount),
+	}, nil
+}
+
+func (h *PostHandler) ListPostLikers(ctx context.Context, req *pb.ListPostLikersRequest) (*pb.ListPostLikersResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	post, err := findVisiblePost(h.DB, viewerID, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	var likes []db.PostLike
+	result := h.DB.Scopes(pagination.Scope("post_likes", cursor, size)).
+		Where("post_id = ?", post.ID).
+		Preload("User").
+		Find(&likes)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get likers")
+	}
+
+	likes, nextPageToken := pagination.Next(likes, size, func(like db.PostLike) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: like.CreatedAt, ID: like.ID}
+	})
+
+	var likers []*pb.PostLiker
+	for _, like := range likes {
+		likers = append(likers, &pb.PostLiker{
+			UserId:   uint32(like.UserID),
+			UserName: like.User.Name,
+			LikedAt:  timestamppb.New(like.CreatedAt),
+		})
+	}
+
+	return &pb.ListPostLikersResponse{
+		Likers:        likers,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// 조회한 게시글 중 userID 가 좋아요를 누른 게시글 ID
+func (h *PostHandler) likedPostIDs(userID uint, posts []db.Post) map[uint]bool {
+	liked := make(map[uint]bool)
+	if userID == anonymousViewer || len(posts) == 0 {
+		return liked
+	}
+
+	postIDs := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+	}
+
+	var likedIDs []uint
+	h.DB.Model(&db.PostLike{}).
+		Where("user_id = ? AND post_id IN ?", userID, postIDs).
+		Pluck("post_id", &likedIDs)
+
+	for _, id := range likedIDs {
+		liked[id] = true
+	}
+
+	return liked
+}
@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"unicode/utf8"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/media"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxMediaSize          = 10 << 20 // 10MB
+	maxMediaChunkSize     = 1 << 20  // grpc 기본 메시지 크기(4MB)보다 작게
+	maxMediaFileName      = 255
+	maxAttachmentsPerPost = 4
+
+	mediaChunkSize = 64 * 1024
+
+	// http.DetectContentType 이 판별에 쓰는 앞부분 길이
+	sniffLength = 512
+)
+
+// 업로드할 수 있는 파일 형식, 선언한 content_type 과 실제 내용에서 판별한 형식이 같아야 한다
+var allowedMediaTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+type MediaHandler struct {
+	pb.UnimplementedMediaServiceServer
+	DB      *gorm.DB
+	JWT     *auth.JWT
+	Storage storage.Storage
+}
+
+func NewMediaHandler(db *gorm.DB, jwt *auth.JWT, storage storage.Storage) *MediaHandler {
+	return &MediaHandler{
+		DB:      db,
+		JWT:     jwt,
+		Storage: storage,
+	}
+}
+
+func (h *MediaHandler) UploadMedia(stream pb.MediaService_UploadMediaServer) error {
+	userID, err := ExtractUserIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "metadata is required")
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first message must be metadata")
+	}
+
+	contentType, err := validateMediaMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	// 받은 내용을 임시 파일에 모아 크기와 체크섬을 확인한 뒤에 저장소로 옮긴다
+	file, err := os.CreateTemp("", "media-*")
+	if err != nil {
+		return status.Error(codes.Internal, "failed to upload media")
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	hash := sha256.New()
+	head := make([]byte, 0, sniffLength)
+	var received int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.GetMetadata() != nil {
+			return status.Error(codes.InvalidArgument, "metadata must be sent only once")
+		}
+
+		chunk := req.GetChunk()
+		if len(chunk) > maxMediaChunkSize {
+			return status.Errorf(codes.InvalidArgument, "chunk must be at most %d bytes", maxMediaChunkSize)
+		}
+
+		received += int64(len(chunk))
+		if received > metadata.GetSize() {
+			return status.Error(codes.InvalidArgument, "upload is larger than the declared size")
+		}
+
+		if len(head) < sniffLength {
+			head = append(head, chunk[:min(len(chunk), sniffLength-len(head))]...)
+		}
+
+		hash.Write(chunk)
+		if _, err := file.Write(chunk); err != nil {
+			return status.Error(codes.Internal, "failed to upload media")
+		}
+	}
+
+	if received != metadata.GetSize() {
+		return status.Error(codes.InvalidArgument, "upload is smaller than the declared size")
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if checksum != metadata.GetSha256() {
+		return status.Error(codes.DataLoss, "checksum does not match")
+	}
+
+	if detected, _, _ := mime.ParseMediaType(http.DetectContentType(head)); detected != contentType {
+		return status.Errorf(codes.InvalidArgument, "content looks like %s, not %s", detected, contentType)
+	}
+
+	token, err := auth.RandomToken()
+	if err != nil {
+		return status.Error(codes.Internal, "failed to upload media")
+	}
+
+	media := db.Media{
+		UserID:      uint(userIDUint),
+		StorageKey:  fmt.Sprintf("media/%d/%s", userIDUint, token),
+		FileName:    filepath.Base(metadata.GetFileName()),
+		ContentType: contentType,
+		Size:        received,
+		Checksum:    checksum,
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return status.Error(codes.Internal, "failed to upload media")
+	}
+
+	if err := h.Storage.Put(stream.Context(), media.StorageKey, file, media.Size, media.ContentType); err != nil {
+		log.Printf("failed to store media %s: %v", media.StorageKey, err)
+		return status.Error(codes.Internal, "failed to upload media")
+	}
+
+	if err := h.DB.Create(&media).Error; err != nil {
+		h.deleteBlob(media.StorageKey)
+		return status.Error(codes.Internal, "failed to upload media")
+	}
+
+	return stream.SendAndClose(&pb.UploadMediaResponse{
+		Media: toMediaMessage(media),
+	})
+}
+
+func (h *MediaHandler) DownloadMedia(req *pb.DownloadMediaRequest, stream pb.MediaService_DownloadMediaServer) error {
+	viewerID, err := ExtractViewerIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	var media db.Media
+	result := h.DB.First(&media, req.GetId())
+	if result.Error != nil {
+		return status.Error(codes.NotFound, "media is not exists")
+	}
+
+	if media.PostID == nil {
+		if viewerID == anonymousViewer || media.UserID != viewerID {
+			return status.Error(codes.NotFound, "media is not exists")
+		}
+	} else if _, err := findVisiblePost(h.DB, viewerID, *media.PostID); err != nil {
+		return status.Error(codes.NotFound, "media is not exists")
+	}
+
+	body, err := h.Storage.Get(stream.Context(), media.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Error(codes.NotFound, "media file is not exists")
+	}
+	if err != nil {
+		log.Printf("failed to read media %s: %v", media.StorageKey, err)
+		return status.Error(codes.Internal, "failed to read media")
+	}
+	defer body.Close()
+
+	buf := make([]byte, mediaChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.MediaChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, "failed to read media")
+		}
+	}
+}
+
+// 저장소에서 지우지 못해도 사용자 요청은 실패시키지 않는다
+func (h *MediaHandler) deleteBlob(key string) {
+	if err := h.Storage.Delete(context.Background(), key); err != nil {
+		log.Printf("failed to delete media %s: %v", key, err)
+	}
+}
+
+// 정규화한 content type 을 반환한다
+func validateMediaMetadata(metadata *pb.MediaMetadata) (string, error) {
+	contentType, _, err := mime.ParseMediaType(metadata.GetContentType())
+	if err != nil || !allowedMediaTypes[contentType] {
+		return "", status.Errorf(codes.InvalidArgument, "content type %q is not allowed", metadata.GetContentType())
+	}
+
+	if metadata.GetSize() <= 0 || metadata.GetSize() > maxMediaSize {
+		return "", status.Errorf(codes.InvalidArgument, "size must be between 1 and %d bytes", maxMediaSize)
+	}
+
+	if checksum, err := hex.DecodeString(metadata.GetSha256()); err != nil || len(checksum) != sha256.Size {
+		return "", status.Error(codes.InvalidArgument, "sha256 must be a hex encoded sha256 checksum")
+	}
+
+	name := metadata.GetFileName()
+	if name == "" || utf8.RuneCountInString(name) > maxMediaFileName || !utf8.ValidString(name) {
+		return "", status.Errorf(codes.InvalidArgument, "file name must be 1 to %d characters", maxMediaFileName)
+	}
+
+	return contentType, nil
+}
+
+// 게시글을 쓰는 트랜잭션(tx) 안에서 호출한다, 본인이 올리고 아직 첨부하지 않은 파일만 붙일 수 있다
+func attachMedia(tx *gorm.DB, userID, postID uint, mediaIDs []uint32) error {
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+
+	if len(mediaIDs) > maxAttachmentsPerPost {
+		return status.Errorf(codes.InvalidArgument, "a post can have up to %d attachments", maxAttachmentsPerPost)
+	}
+
+	seen := make(map[uint32]bool)
+	for _, id := range mediaIDs {
+		if seen[id] {
+			return status.Error(codes.InvalidArgument, "media ids must be unique")
+		}
+		seen[id] = true
+	}
+
+	result := tx.Model(&db.Media{}).
+		Where("id IN ? AND user_id = ? AND post_id IS NULL", mediaIDs, userID).
+		Update("post_id", postID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected != int64(len(mediaIDs)) {
+		return status.Error(codes.InvalidArgument, "media is not exists or already attached")
+	}
+
+	return nil
+}
+
+func toMediaMessages(media []db.Media) []*pb.Media {
+	var messages []*pb.Media
+	for _, m := range media {
+		messages = append(messages, toMediaMessage(m))
+	}
+	return messages
+}
+
+func toMediaMessage(media db.Media) *pb.Media {
+	return &pb.Media{
+		Id:          uint32(media.ID),
+		FileName:    media.FileName,
+		ContentType: media.ContentType,
+		Size:        media.Size,
+		Sha256:      media.Checksum,
+		CreatedAt:   timestamppb.New(media.CreatedAt),
+	}
+}
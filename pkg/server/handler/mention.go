@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"sort"
+	"strings"
+
+	commentpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/comment"
+	postpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/parser"
+	"gorm.io/gorm"
+)
+
+// 본문의 @user_id 를 사용자와 매칭해 mentions 를 다시 저장한다
+// commentID 가 nil 이면 게시글 본문, 아니면 해당 댓글 본문의 멘션이다
+// 존재하지 않는 아이디는 저장하지 않고 일반 텍스트로 남기며, 새로 언급된 사용자 중 게시글을 볼 수 있는 사용자에게만 알림을 보낸다
+func syncMentions(tx *gorm.DB, actorID uint, content string, postID uint, commentID *uint) ([]db.Mention, error) {
+	existing := tx.Where("post_id = ?", postID)
+	if commentID != nil {
+		existing = tx.Where("comment_id = ?", *commentID)
+	}
+	existing = existing.Session(&gorm.Session{})
+
+	var previousUserIDs []uint
+	if err := existing.Model(&db.Mention{}).Pluck("user_id", &previousUserIDs).Error; err != nil {
+		return nil, err
+	}
+
+	if err := existing.Delete(&db.Mention{}).Error; err != nil {
+		return nil, err
+	}
+
+	alreadyMentioned := make(map[uint]bool)
+	for _, userID := range previousUserIDs {
+		alreadyMentioned[userID] = true
+	}
+
+	parsed := parser.ExtractMentions(content)
+	if len(parsed) == 0 {
+		return nil, nil
+	}
+
+	handles := make([]string, 0, len(parsed))
+	for _, mention := range parsed {
+		handles = append(handles, mention.Handle)
+	}
+
+	var users []db.User
+	if err := tx.Where("user_id IN ?", handles).Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	usersByHandle := make(map[string]db.User)
+	for _, user := range users {
+		usersByHandle[strings.ToLower(user.UserId)] = user
+	}
+
+	mentions := make([]db.Mention, 0, len(parsed))
+	for _, mention := range parsed {
+		user, ok := usersByHandle[strings.ToLower(mention.Handle)]
+		if !ok {
+			continue
+		}
+
+		mention := db.Mention{
+			CommentID: commentID,
+			UserID:    user.ID,
+			User:      user,
+			Offset:    uint(mention.Offset),
+			Length:    uint(mention.Length),
+		}
+		if commentID == nil {
+			mention.PostID = &postID
+		}
+		mentions = append(mentions, mention)
+	}
+
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	if err := tx.Omit("User").Create(&mentions).Error; err != nil {
+		return nil, err
+	}
+
+	var post db.Post
+	if err := tx.First(&post, postID).Error; err != nil {
+		return nil, err
+	}
+
+	for _, mention := range mentions {
+		if alreadyMentioned[mention.UserID] {
+			continue
+		}
+		alreadyMentioned[mention.UserID] = true
+
+		if !canViewPost(tx, mention.UserID, post) {
+			continue
+		}
+
+		err := createNotification(tx, db.Notification{
+			UserID:    mention.UserID,
+			ActorID:   actorID,
+			Type:      db.NotificationTypeMention,
+			PostID:    &postID,
+			CommentID: commentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mentions, nil
+}
+
+func sortMentions(mentions []db.Mention) []db.Mention {
+	sort.SliceStable(mentions, func(i, j int) bool {
+		return mentions[i].Offset < mentions[j].Offset
+	})
+	return mentions
+}
+
+func toPostMentions(mentions []db.Mention) []*postpb.Mention {
+	var pbMentions []*postpb.Mention
+	for _, mention := range sortMentions(mentions) {
+		pbMentions = append(pbMentions, &postpb.Mention{
+			Offset:   uint32(mention.Offset),
+			Length:   uint32(mention.Length),
+			UserId:   uint32(mention.UserID),
+			UserName: mention.User.Name,
+		})
+	}
+
+	return pbMentions
+}
+
+func toCommentMentions(mentions []db.Mention) []*commentpb.Mention {
+	var pbMentions []*commentpb.Mention
+	for _, mention := range sortMentions(mentions) {
+		pbMentions = append(pbMentions, &commentpb.Mention{
+			Offset:   uint32(mention.Offset),
+			Length:   uint32(mention.Length),
+			UserId:   uint32(mention.UserID),
+			UserName: mention.User.Name,
+		})
+	}
+
+	return pbMentions
+}
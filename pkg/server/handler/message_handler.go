@@ -0,0 +1,595 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/message"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxConversationMembers = 10
+	maxMessageLength       = 1000
+)
+
+type MessageHandler struct {
+	pb.UnimplementedMessageServiceServer
+	DB     *gorm.DB
+	JWT    *auth.JWT
+	Broker pubsub.Broker
+}
+
+func NewMessageHandler(db *gorm.DB, jwt *auth.JWT, broker pubsub.Broker) *MessageHandler {
+	return &MessageHandler{
+		DB:     db,
+		JWT:    jwt,
+		Broker: broker,
+	}
+}
+
+func (h *MessageHandler) CreateConversation(ctx context.Context, req *pb.CreateConversationRequest) (*pb.CreateConversationResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := make([]string, 0, len(req.GetUserIds()))
+	seen := make(map[string]bool)
+	for _, handle := range req.GetUserIds() {
+		if handle == "" || seen[strings.ToLower(handle)] {
+			continue
+		}
+		seen[strings.ToLower(handle)] = true
+		handles = append(handles, handle)
+	}
+
+	var found []db.User
+	if len(handles) > 0 {
+		h.DB.Where("user_id IN ?", handles).Find(&found)
+	}
+
+	if len(found) != len(handles) {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	users := make([]db.User, 0, len(found))
+	for _, user := range found {
+		if user.ID != uint(userIDUint) {
+			users = append(users, user)
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one other user is required")
+	}
+
+	if len(users)+1 > maxConversationMembers {
+		return nil, status.Errorf(codes.InvalidArgument, "a conversation can have up to %d members", maxConversationMembers)
+	}
+
+	for _, user := range users {
+		if isBlocked(h.DB, uint(userIDUint), user.ID) {
+			return nil, status.Errorf(codes.PermissionDenied, "you can't start a conversation with %s", user.UserId)
+		}
+	}
+
+	conversation := db.Conversation{
+		IsGroup:       len(users) > 1,
+		LastMessageAt: time.Now(),
+		Members:       []db.ConversationMember{{UserID: uint(userIDUint)}},
+	}
+	for _, user := range users {
+		conversation.Members = append(conversation.Members, db.ConversationMember{UserID: user.ID})
+	}
+
+	if !conversation.IsGroup {
+		key := directKey(uint(userIDUint), users[0].ID)
+		conversation.DirectKey = &key
+
+		var existing db.Conversation
+		result := h.DB.Where("direct_key = ?", key).First(&existing)
+		if result.Error == nil {
+			return h.conversationResponse(existing.ID, uint(userIDUint))
+		}
+	}
+
+	result := h.DB.Create(&conversation)
+	if result.Error != nil {
+		// 동시에 같은 1:1 대화를 만든 경우 먼저 만들어진 대화를 반환한다
+		if conversation.DirectKey != nil {
+			var existing db.Conversation
+			if h.DB.Where("direct_key = ?", *conversation.DirectKey).First(&existing).Error == nil {
+				return h.conversationResponse(existing.ID, uint(userIDUint))
+			}
+		}
+		return nil, status.Error(codes.Internal, "failed to create conversation")
+	}
+
+	return h.conversationResponse(conversation.ID, uint(userIDUint))
+}
+
+func (h *MessageHandler) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var conversations []db.Conversation
+	result := h.DB.Joins("JOIN conversation_members ON conversation_members.conversation_id = conversations.id AND conversation_members.user_id = ?", userIDUint).
+		Scopes(pagination.ScopeBy("conversations", "last_message_at", cursor, size)).
+		Preload("Members.User").
+		Find(&conversations)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get conversations")
+	}
+
+	conversations, nextPageToken := pagination.Next(conversations, size, func(conversation db.Conversation) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: conversation.LastMessageAt, ID: conversation.ID}
+	})
+
+	conversationIDs := make([]uint, 0, len(conversations))
+	for _, conversation := range conversations {
+		conversationIDs = append(conversationIDs, conversation.ID)
+	}
+
+	lastMessages := h.lastMessages(conversationIDs)
+	unreadCounts := h.unreadCounts(uint(userIDUint), conversationIDs)
+
+	var pbConversations []*pb.Conversation
+	for _, conversation := range conversations {
+		pbConversation := toConversationMessage(conversation)
+		if message, ok := lastMessages[conversation.ID]; ok {
+			pbConversation.LastMessage = toMessageMessage(message)
+		}
+		pbConversation.UnreadCount = uint32(unreadCounts[conversation.ID])
+		pbConversations = append(pbConversations, pbConversation)
+	}
+
+	return &pb.ListConversationsResponse{
+		Conversations: pbConversations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *MessageHandler) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := h.sendMessage(uint(userIDUint), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SendMessageResponse{
+		Message: toMessageMessage(*message),
+	}, nil
+}
+
+func (h *MessageHandler) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	if _, err := h.members(uint(req.GetConversationId()), uint(userIDUint)); err != nil {
+		return nil, err
+	}
+
+	var messages []db.Message
+	result := h.DB.Scopes(pagination.Scope("messages", cursor, size)).
+		Where("conversation_id = ?", req.GetConversationId()).
+		Preload("Sender").
+		Find(&messages)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get messages")
+	}
+
+	messages, nextPageToken := pagination.Next(messages, size, func(message db.Message) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: message.CreatedAt, ID: message.ID}
+	})
+
+	var pbMessages []*pb.Message
+	for _, message := range messages {
+		pbMessages = append(pbMessages, toMessageMessage(message))
+	}
+
+	return &pb.ListMessagesResponse{
+		Messages:      pbMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *MessageHandler) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := h.markRead(uint(userIDUint), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkReadResponse{
+		ReadReceipt: receipt,
+	}, nil
+}
+
+// Chat 은 메시지 전송과 읽음 처리를 받고, 내가 참여한 모든 대화의 새 메시지와 읽음 표시를 내려준다
+func (h *MessageHandler) Chat(stream pb.MessageService_ChatServer) error {
+	userID, err := ExtractUserIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	events, cancel, err := h.Broker.Subscribe([]string{pubsub.ChatTopic(uint(userIDUint))})
+	if err != nil {
+		return status.Error(codes.Internal, "failed to subscribe")
+	}
+	defer cancel()
+
+	// stream.Send 는 이 함수에서만 호출하고 Recv 는 별도 고루틴에서 받는다
+	requests := make(chan *pb.ChatRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case requests <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case req := <-requests:
+			switch action := req.GetAction().(type) {
+			case *pb.ChatRequest_Send:
+				_, err = h.sendMessage(uint(userIDUint), action.Send)
+			case *pb.ChatRequest_Read:
+				_, err = h.markRead(uint(userIDUint), action.Read)
+			default:
+				err = status.Error(codes.InvalidArgument, "action is required")
+			}
+			// 요청 하나가 실패해도 스트림은 유지하고 클라이언트에 오류만 알린다
+			if err != nil {
+				st := status.Convert(err)
+				event := &pb.ChatEvent{
+					Event: &pb.ChatEvent_Error{
+						Error: &pb.ChatError{
+							Code:    uint32(st.Code()),
+							Message: st.Message(),
+						},
+					},
+				}
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+		case message, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription is closed")
+			}
+
+			var event pb.ChatEvent
+			if err := proto.Unmarshal(message.Data, &event); err != nil {
+				log.Printf("failed to unmarshal chat event from %s: %v", message.Topic, err)
+				continue
+			}
+
+			if err := stream.Send(&event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *MessageHandler) sendMessage(userID uint, req *pb.SendMessageRequest) (*db.Message, error) {
+	content := strings.TrimSpace(req.GetContent())
+	if content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	if utf8.RuneCountInString(content) > maxMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxMessageLength)
+	}
+
+	members, err := h.members(uint(req.GetConversationId()), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var conversation db.Conversation
+	if err := h.DB.First(&conversation, req.GetConversationId()).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "conversation is not exists")
+	}
+
+	// 1:1 대화에서는 차단 관계가 되면 더 이상 메시지를 보낼 수 없다
+	// 그룹 대화는 참여자가 둘만 남아도 그룹으로 본다
+	if !conversation.IsGroup {
+		for _, member := range members {
+			if member.UserID != userID && isBlocked(h.DB, userID, member.UserID) {
+				return nil, status.Error(codes.PermissionDenied, "you can't send messages to this user")
+			}
+		}
+	}
+
+	message := db.Message{
+		ConversationID: uint(req.GetConversationId()),
+		SenderID:       userID,
+		Content:        content,
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&message).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&db.Conversation{}).
+			Where("id = ?", message.ConversationID).
+			Update("last_message_at", message.CreatedAt).Error
+		if err != nil {
+			return err
+		}
+
+		// 내가 보낸 메시지는 읽은 것으로 본다
+		return tx.Model(&db.ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ?", message.ConversationID, userID).
+			Update("last_read_message_id", message.ID).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send message")
+	}
+
+	h.DB.First(&message.Sender, userID)
+
+	h.publishChatEvent(members, &pb.ChatEvent{
+		Event: &pb.ChatEvent_Message{Message: toMessageMessage(message)},
+	})
+
+	return &message, nil
+}
+
+func (h *MessageHandler) markRead(userID uint, req *pb.MarkReadRequest) (*pb.ReadReceipt, error) {
+	members, err := h.members(uint(req.GetConversationId()), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var message db.Message
+	result := h.DB.Where("id = ? AND conversation_id = ?", req.GetMessageId(), req.GetConversationId()).First(&message)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "message is not exists")
+	}
+
+	// 이미 더 뒤의 메시지까지 읽었다면 읽음 표시를 되돌리지 않는다
+	result = h.DB.Model(&db.ConversationMember{}).
+		Where("conversation_id = ? AND user_id = ? AND last_read_message_id < ?", message.ConversationID, userID, message.ID).
+		Update("last_read_message_id", message.ID)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to mark as read")
+	}
+
+	var member db.ConversationMember
+	h.DB.Where("conversation_id = ? AND user_id = ?", message.ConversationID, userID).First(&member)
+
+	receipt := &pb.ReadReceipt{
+		ConversationId:    uint32(member.ConversationID),
+		UserId:            uint32(member.UserID),
+		LastReadMessageId: uint32(member.LastReadMessageID),
+	}
+
+	if result.RowsAffected > 0 {
+		h.publishChatEvent(members, &pb.ChatEvent{
+			Event: &pb.ChatEvent_ReadReceipt{ReadReceipt: receipt},
+		})
+	}
+
+	return receipt, nil
+}
+
+// 대화에 참여하지 않은 사용자에게는 대화가 존재하는지 알리지 않는다
+func (h *MessageHandler) members(conversationID, userID uint) ([]db.ConversationMember, error) {
+	var members []db.ConversationMember
+	h.DB.Where("conversation_id = ?", conversationID).Find(&members)
+
+	for _, member := range members {
+		if member.UserID == userID {
+			return members, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "conversation is not exists")
+}
+
+func (h *MessageHandler) publishChatEvent(members []db.ConversationMember, event *pb.ChatEvent) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal chat event: %v", err)
+		return
+	}
+
+	for _, member := range members {
+		topic := pubsub.ChatTopic(member.UserID)
+		if err := h.Broker.Publish(topic, data); err != nil {
+			log.Printf("failed to publish chat event to %s: %v", topic, err)
+		}
+	}
+}
+
+func (h *MessageHandler) conversationResponse(conversationID, userID uint) (*pb.CreateConversationResponse, error) {
+	var conversation db.Conversation
+	result := h.DB.Preload("Members.User").First(&conversation, conversationID)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "conversation is not exists")
+	}
+
+	pbConversation := toConversationMessage(conversation)
+	if message, ok := h.lastMessages([]uint{conversation.ID})[conversation.ID]; ok {
+		pbConversation.LastMessage = toMessageMessage(message)
+	}
+	pbConversation.UnreadCount = uint32(h.unreadCounts(userID, []uint{conversation.ID})[conversation.ID])
+
+	return &pb.CreateConversationResponse{
+		Conversation: pbConversation,
+	}, nil
+}
+
+func (h *MessageHandler) lastMessages(conversationIDs []uint) map[uint]db.Message {
+	lastMessages := make(map[uint]db.Message)
+	if len(conversationIDs) == 0 {
+		return lastMessages
+	}
+
+	latest := h.DB.Model(&db.Message{}).
+		Select("MAX(id)").
+		Where("conversation_id IN ?", conversationIDs).
+		Group("conversation_id")
+
+	var messages []db.Message
+	h.DB.Where("id IN (?)", latest).Preload("Sender").Find(&messages)
+
+	for _, message := range messages {
+		lastMessages[message.ConversationID] = message
+	}
+
+	return lastMessages
+}
+
+// 내가 마지막으로 읽은 메시지 이후에 다른 사람이 보낸 메시지 수
+func (h *MessageHandler) unreadCounts(userID uint, conversationIDs []uint) map[uint]int64 {
+	counts := make(map[uint]int64)
+	if len(conversationIDs) == 0 {
+		return counts
+	}
+
+	var rows []struct {
+		ConversationID uint
+		Count          int64
+	}
+	h.DB.Model(&db.Message{}).
+		Select("messages.conversation_id, COUNT(*) AS count").
+		Joins("JOIN conversation_members ON conversation_members.conversation_id = messages.conversation_id AND conversation_members.user_id = ?", userID).
+		Where("messages.conversation_id IN ?", conversationIDs).
+		Where("messages.id > conversation_members.last_read_message_id AND messages.sender_id <> ?", userID).
+		Group("messages.conversation_id").
+		Scan(&rows)
+
+	for _, row := range rows {
+		counts[row.ConversationID] = row.Count
+	}
+
+	return counts
+}
+
+func directKey(a, b uint) string {
+	if a > b {
+		a, b = b, a
+	}
+	return fmt.Sprintf("%d:%d", a, b)
+}
+
+func toConversationMessage(conversation db.Conversation) *pb.Conversation {
+	var participants []*pb.Participant
+	for _, member := range conversation.Members {
+		participants = append(participants, &pb.Participant{
+			Id:                uint32(member.UserID),
+			UserId:            member.User.UserId,
+			Name:              member.User.Name,
+			LastReadMessageId: uint32(member.LastReadMessageID),
+		})
+	}
+
+	return &pb.Conversation{
+		Id:            uint32(conversation.ID),
+		IsGroup:       conversation.IsGroup,
+		Participants:  participants,
+		CreatedAt:     timestamppb.New(conversation.CreatedAt),
+		LastMessageAt: timestamppb.New(conversation.LastMessageAt),
+	}
+}
+
+func toMessageMessage(message db.Message) *pb.Message {
+	return &pb.Message{
+		Id:             uint32(message.ID),
+		ConversationId: uint32(message.ConversationID),
+		SenderId:       uint32(message.SenderID),
+		SenderName:     message.Sender.Name,
+		Content:        message.Content,
+		CreatedAt:      timestamppb.New(message.CreatedAt),
+	}
+}
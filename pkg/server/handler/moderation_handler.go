@@ -0,0 +1,565 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/moderation"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReportDetailLength = 500
+	maxModerationNote     = 500
+
+	// duration_hours 없이 정지하면 사실상 영구 정지
+	indefiniteSuspension = 100 * 365 * 24 * time.Hour
+)
+
+var reportTargetTypes = map[pb.ReportTargetType]string{
+	pb.ReportTargetType_REPORT_TARGET_TYPE_POST:    db.ReportTargetPost,
+	pb.ReportTargetType_REPORT_TARGET_TYPE_COMMENT: db.ReportTargetComment,
+	pb.ReportTargetType_REPORT_TARGET_TYPE_USER:    db.ReportTargetUser,
+}
+
+var reportTargetTypeNames = map[string]pb.ReportTargetType{
+	db.ReportTargetPost:    pb.ReportTargetType_REPORT_TARGET_TYPE_POST,
+	db.ReportTargetComment: pb.ReportTargetType_REPORT_TARGET_TYPE_COMMENT,
+	db.ReportTargetUser:    pb.ReportTargetType_REPORT_TARGET_TYPE_USER,
+}
+
+var reportReasons = map[pb.ReportReason]string{
+	pb.ReportReason_REPORT_REASON_SPAM:           db.ReportReasonSpam,
+	pb.ReportReason_REPORT_REASON_HARASSMENT:     db.ReportReasonHarassment,
+	pb.ReportReason_REPORT_REASON_HATE_SPEECH:    db.ReportReasonHateSpeech,
+	pb.ReportReason_REPORT_REASON_VIOLENCE:       db.ReportReasonViolence,
+	pb.ReportReason_REPORT_REASON_SEXUAL_CONTENT: db.ReportReasonSexualContent,
+	pb.ReportReason_REPORT_REASON_OTHER:          db.ReportReasonOther,
+}
+
+var reportReasonNames = map[string]pb.ReportReason{
+	db.ReportReasonSpam:          pb.ReportReason_REPORT_REASON_SPAM,
+	db.ReportReasonHarassment:    pb.ReportReason_REPORT_REASON_HARASSMENT,
+	db.ReportReasonHateSpeech:    pb.ReportReason_REPORT_REASON_HATE_SPEECH,
+	db.ReportReasonViolence:      pb.ReportReason_REPORT_REASON_VIOLENCE,
+	db.ReportReasonSexualContent: pb.ReportReason_REPORT_REASON_SEXUAL_CONTENT,
+	db.ReportReasonOther:         pb.ReportReason_REPORT_REASON_OTHER,
+}
+
+var reportStatuses = map[pb.ReportStatus]string{
+	pb.ReportStatus_REPORT_STATUS_OPEN:      db.ReportStatusOpen,
+	pb.ReportStatus_REPORT_STATUS_RESOLVED:  db.ReportStatusResolved,
+	pb.ReportStatus_REPORT_STATUS_DISMISSED: db.ReportStatusDismissed,
+}
+
+var roles = map[pb.Role]string{
+	pb.Role_ROLE_USER:      db.RoleUser,
+	pb.Role_ROLE_MODERATOR: db.RoleModerator,
+	pb.Role_ROLE_ADMIN:     db.RoleAdmin,
+}
+
+var reportStatusNames = map[string]pb.ReportStatus{
+	db.ReportStatusOpen:      pb.ReportStatus_REPORT_STATUS_OPEN,
+	db.ReportStatusResolved:  pb.ReportStatus_REPORT_STATUS_RESOLVED,
+	db.ReportStatusDismissed: pb.ReportStatus_REPORT_STATUS_DISMISSED,
+}
+
+type ModerationHandler struct {
+	pb.UnimplementedModerationServiceServer
+	DB  *gorm.DB
+	JWT *auth.JWT
+}
+
+func NewModerationHandler(db *gorm.DB, jwt *auth.JWT) *ModerationHandler {
+	return &ModerationHandler{
+		DB:  db,
+		JWT: jwt,
+	}
+}
+
+func (h *ModerationHandler) ReportContent(ctx context.Context, req *pb.ReportContentRequest) (*pb.ReportContentResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetType, ok := reportTargetTypes[req.GetTargetType()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "target type is required")
+	}
+
+	reason, ok := reportReasons[req.GetReason()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	if utf8.RuneCountInString(req.GetDetail()) > maxReportDetailLength {
+		return nil, status.Errorf(codes.InvalidArgument, "detail must be at most %d characters", maxReportDetailLength)
+	}
+
+	// 신고하는 사용자가 볼 수 없는 글은 신고할 수 없다
+	var targetID uint
+	switch targetType {
+	case db.ReportTargetPost:
+		post, err := findVisiblePost(h.DB, uint(userIDUint), uint(req.GetTargetId()))
+		if err != nil {
+			return nil, err
+		}
+		targetID = post.ID
+	case db.ReportTargetComment:
+		var comment db.Comment
+		result := h.DB.Where("hidden_at IS NULL").First(&comment, req.GetTargetId())
+		if result.Error != nil {
+			return nil, status.Error(codes.NotFound, "comment is not exists")
+		}
+		if _, err := findVisiblePost(h.DB, uint(userIDUint), comment.PostID); err != nil {
+			return nil, status.Error(codes.NotFound, "comment is not exists")
+		}
+		targetID = comment.ID
+	case db.ReportTargetUser:
+		var user db.User
+		result := h.DB.Where("user_id = ?", req.GetUserId()).First(&user)
+		if result.Error != nil {
+			return nil, status.Error(codes.NotFound, "user is not exists")
+		}
+		targetID = user.ID
+	}
+
+	report := db.Report{
+		ReporterID: uint(userIDUint),
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     reason,
+		Detail:     req.GetDetail(),
+		Status:     db.ReportStatusOpen,
+	}
+
+	var count int64
+	h.DB.Model(&db.Report{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ?", report.ReporterID, report.TargetType, report.TargetID).
+		Count(&count)
+	if count > 0 {
+		return nil, status.Error(codes.AlreadyExists, "you already reported this content")
+	}
+
+	if err := h.DB.Create(&report).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to report content")
+	}
+
+	return &pb.ReportContentResponse{
+		ReportId: uint32(report.ID),
+	}, nil
+}
+
+func (h *ModerationHandler) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	if _, err := moderatorFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	reportStatus := db.ReportStatusOpen
+	if req.GetStatus() != pb.ReportStatus_REPORT_STATUS_UNSPECIFIED {
+		reportStatus = reportStatuses[req.GetStatus()]
+	}
+
+	query := h.DB.Scopes(pagination.Scope("reports", cursor, size)).
+		Where("status = ?", reportStatus)
+	if targetType, ok := reportTargetTypes[req.GetTargetType()]; ok {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	var reports []db.Report
+	result := query.Preload("Reporter").Preload("ResolvedBy").Find(&reports)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get reports")
+	}
+
+	reports, nextPageToken := pagination.Next(reports, size, func(report db.Report) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: report.CreatedAt, ID: report.ID}
+	})
+
+	var pbReports []*pb.Report
+	for _, report := range reports {
+		pbReports = append(pbReports, toReportMessage(report))
+	}
+
+	return &pb.ListReportsResponse{
+		Reports:       pbReports,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *ModerationHandler) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ResolveReportResponse, error) {
+	moderatorID, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetStatus() != pb.ReportStatus_REPORT_STATUS_RESOLVED && req.GetStatus() != pb.ReportStatus_REPORT_STATUS_DISMISSED {
+		return nil, status.Error(codes.InvalidArgument, "status must be resolved or dismissed")
+	}
+
+	if utf8.RuneCountInString(req.GetNote()) > maxModerationNote {
+		return nil, status.Errorf(codes.InvalidArgument, "note must be at most %d characters", maxModerationNote)
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		report, err := closeReport(tx, uint(req.GetId()), moderatorID, reportStatuses[req.GetStatus()], req.GetNote())
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&db.ModerationAction{
+			ModeratorID: moderatorID,
+			Action:      db.ModerationActionResolveReport,
+			TargetType:  report.TargetType,
+			TargetID:    report.TargetID,
+			ReportID:    &report.ID,
+			Reason:      req.GetNote(),
+		}).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to resolve report")
+	}
+
+	var report db.Report
+	result := h.DB.Preload("Reporter").Preload("ResolvedBy").First(&report, req.GetId())
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get report")
+	}
+
+	return &pb.ResolveReportResponse{
+		Report: toReportMessage(report),
+	}, nil
+}
+
+func (h *ModerationHandler) HidePost(ctx context.Context, req *pb.HidePostRequest) (*pb.HidePostResponse, error) {
+	moderatorID, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if utf8.RuneCountInString(req.GetReason()) > maxModerationNote {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxModerationNote)
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		var post db.Post
+		if err := tx.First(&post, req.GetId()).Error; err != nil {
+			return status.Error(codes.NotFound, "post is not exists")
+		}
+
+		if post.HiddenAt != nil {
+			return status.Error(codes.FailedPrecondition, "post is already hidden")
+		}
+
+		if err := tx.Model(&post).UpdateColumn("hidden_at", time.Now()).Error; err != nil {
+			return err
+		}
+
+		return recordModeration(tx, db.ModerationAction{
+			ModeratorID: moderatorID,
+			Action:      db.ModerationActionHidePost,
+			TargetType:  db.ReportTargetPost,
+			TargetID:    post.ID,
+			Reason:      req.GetReason(),
+		}, uint(req.GetReportId()))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to hide post")
+	}
+
+	return &pb.HidePostResponse{
+		Message: fmt.Sprintf("post %d is hidden", req.GetId()),
+	}, nil
+}
+
+func (h *ModerationHandler) HideComment(ctx context.Context, req *pb.HideCommentRequest) (*pb.HideCommentResponse, error) {
+	moderatorID, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if utf8.RuneCountInString(req.GetReason()) > maxModerationNote {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxModerationNote)
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		var comment db.Comment
+		if err := tx.First(&comment, req.GetId()).Error; err != nil {
+			return status.Error(codes.NotFound, "comment is not exists")
+		}
+
+		if comment.HiddenAt != nil {
+			return status.Error(codes.FailedPrecondition, "comment is already hidden")
+		}
+
+		if err := tx.Model(&comment).UpdateColumn("hidden_at", time.Now()).Error; err != nil {
+			return err
+		}
+
+		return recordModeration(tx, db.ModerationAction{
+			ModeratorID: moderatorID,
+			Action:      db.ModerationActionHideComment,
+			TargetType:  db.ReportTargetComment,
+			TargetID:    comment.ID,
+			Reason:      req.GetReason(),
+		}, uint(req.GetReportId()))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to hide comment")
+	}
+
+	return &pb.HideCommentResponse{
+		Message: fmt.Sprintf("comment %d is hidden", req.GetId()),
+	}, nil
+}
+
+func (h *ModerationHandler) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	moderatorID, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if utf8.RuneCountInString(req.GetReason()) > maxModerationNote {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxModerationNote)
+	}
+
+	var user db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if user.ID == moderatorID {
+		return nil, status.Error(codes.InvalidArgument, "you can't suspend yourself")
+	}
+
+	duration := indefiniteSuspension
+	if req.GetDurationHours() != 0 {
+		duration = time.Duration(req.GetDurationHours()) * time.Hour
+	}
+	suspendedUntil := time.Now().Add(duration)
+
+	// 정지된 사용자의 세션을 모두 끊어 이미 발급된 토큰도 바로 쓸 수 없게 한다
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).UpdateColumn("suspended_until", suspendedUntil).Error; err != nil {
+			return err
+		}
+
+		var sessionIDs []uint
+		err := tx.Model(&db.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", user.ID).
+			Pluck("id", &sessionIDs).Error
+		if err != nil {
+			return err
+		}
+
+		if err := revokeSessions(tx, sessionIDs); err != nil {
+			return err
+		}
+
+		return recordModeration(tx, db.ModerationAction{
+			ModeratorID: moderatorID,
+			Action:      db.ModerationActionSuspendUser,
+			TargetType:  db.ReportTargetUser,
+			TargetID:    user.ID,
+			Reason:      req.GetReason(),
+		}, uint(req.GetReportId()))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to suspend user")
+	}
+
+	return &pb.SuspendUserResponse{
+		Message:        fmt.Sprintf("%s is suspended", user.UserId),
+		SuspendedUntil: timestamppb.New(suspendedUntil),
+	}, nil
+}
+
+func (h *ModerationHandler) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	moderatorID, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := roles[req.GetRole()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	var user db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	// 관리자가 실수로 자기 권한을 잃지 않도록 막는다
+	if user.ID == moderatorID {
+		return nil, status.Error(codes.InvalidArgument, "you can't change your own role")
+	}
+
+	if user.Role == role {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is already %s", user.UserId, role)
+	}
+
+	// 이전 역할이 담긴 access token 이 남지 않도록 세션을 모두 끊는다
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).UpdateColumn("role", role).Error; err != nil {
+			return err
+		}
+
+		var sessionIDs []uint
+		err := tx.Model(&db.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", user.ID).
+			Pluck("id", &sessionIDs).Error
+		if err != nil {
+			return err
+		}
+
+		if err := revokeSessions(tx, sessionIDs); err != nil {
+			return err
+		}
+
+		return recordModeration(tx, db.ModerationAction{
+			ModeratorID: moderatorID,
+			Action:      db.ModerationActionSetRole,
+			TargetType:  db.ReportTargetUser,
+			TargetID:    user.ID,
+			Reason:      role,
+		}, 0)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to set user role")
+	}
+
+	return &pb.SetUserRoleResponse{
+		Message: fmt.Sprintf("%s is now %s", user.UserId, role),
+	}, nil
+}
+
+// 역할 검사는 PermissionInterceptor 가 하고, 여기서는 조치를 기록할 사용자 ID 만 꺼낸다
+func moderatorFromContext(ctx context.Context) (uint, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(userIDUint), nil
+}
+
+// 작성자 본인이거나 moderator 이상의 역할이면 글을 수정/삭제할 수 있다
+func canModify(ctx context.Context, ownerID, userID uint) bool {
+	return ownerID == userID || auth.HasRole(ExtractRoleFromContext(ctx), db.RoleModerator)
+}
+
+// 다른 사용자의 글을 고친 경우에만 조치 기록을 남긴다
+func recordModeratorEdit(tx *gorm.DB, ownerID, userID uint, action, targetType string, targetID uint) error {
+	if ownerID == userID {
+		return nil
+	}
+
+	return recordModeration(tx, db.ModerationAction{
+		ModeratorID: userID,
+		Action:      action,
+		TargetType:  targetType,
+		TargetID:    targetID,
+	}, 0)
+}
+
+// 조치를 기록하고, 신고를 보고 조치했다면 그 신고를 함께 닫는다
+func recordModeration(tx *gorm.DB, action db.ModerationAction, reportID uint) error {
+	if reportID != 0 {
+		if _, err := closeReport(tx, reportID, action.ModeratorID, db.ReportStatusResolved, action.Reason); err != nil {
+			return err
+		}
+		action.ReportID = &reportID
+	}
+
+	return tx.Create(&action).Error
+}
+
+func closeReport(tx *gorm.DB, reportID, moderatorID uint, reportStatus, note string) (db.Report, error) {
+	var report db.Report
+	if err := tx.First(&report, reportID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return report, status.Error(codes.NotFound, "report is not exists")
+		}
+		return report, err
+	}
+
+	if report.Status != db.ReportStatusOpen {
+		return report, status.Error(codes.FailedPrecondition, "report is already closed")
+	}
+
+	err := tx.Model(&report).Updates(map[string]interface{}{
+		"status":         reportStatus,
+		"resolved_by_id": moderatorID,
+		"note":           note,
+		"resolved_at":    time.Now(),
+	}).Error
+	return report, err
+}
+
+func toReportMessage(report db.Report) *pb.Report {
+	var resolvedBy string
+	if report.ResolvedBy != nil {
+		resolvedBy = report.ResolvedBy.UserId
+	}
+
+	var resolvedAt *timestamppb.Timestamp
+	if report.ResolvedAt != nil {
+		resolvedAt = timestamppb.New(*report.ResolvedAt)
+	}
+
+	return &pb.Report{
+		Id:               uint32(report.ID),
+		ReporterUserId:   report.Reporter.UserId,
+		TargetType:       reportTargetTypeNames[report.TargetType],
+		TargetId:         uint32(report.TargetID),
+		Reason:           reportReasonNames[report.Reason],
+		Detail:           report.Detail,
+		Status:           reportStatusNames[report.Status],
+		ResolvedByUserId: resolvedBy,
+		Note:             report.Note,
+		CreatedAt:        timestamppb.New(report.CreatedAt),
+		ResolvedAt:       resolvedAt,
+	}
+}
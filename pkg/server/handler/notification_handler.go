@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/notification"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var notificationTypes = map[string]pb.NotificationType{
+	db.NotificationTypeComment:     pb.NotificationType_NOTIFICATION_TYPE_COMMENT,
+	db.NotificationTypeReply:       pb.NotificationType_NOTIFICATION_TYPE_REPLY,
+	db.NotificationTypePostLike:    pb.NotificationType_NOTIFICATION_TYPE_POST_LIKE,
+	db.NotificationTypeCommentLike: pb.NotificationType_NOTIFICATION_TYPE_COMMENT_LIKE,
+	db.NotificationTypeFollow:      pb.NotificationType_NOTIFICATION_TYPE_FOLLOW,
+	db.NotificationTypeMention:     pb.NotificationType_NOTIFICATION_TYPE_MENTION,
+}
+
+// Subscribe 한 번에 지켜볼 수 있는 게시글 수
+const maxWatchedPosts = 100
+
+type NotificationHandler struct {
+	pb.UnimplementedNotificationServiceServer
+	DB     *gorm.DB
+	JWT    *auth.JWT
+	Broker pubsub.Broker
+}
+
+func NewNotificationHandler(db *gorm.DB, jwt *auth.JWT, broker pubsub.Broker) *NotificationHandler {
+	return &NotificationHandler{
+		DB:     db,
+		JWT:    jwt,
+		Broker: broker,
+	}
+}
+
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	query := h.DB.Scopes(pagination.Scope("notifications", cursor, size)).
+		Where("user_id = ?", userIDUint)
+	if req.GetUnreadOnly() {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var notifications []db.Notification
+	result := query.Preload("Actor").Find(&notifications)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get notifications")
+	}
+
+	notifications, nextPageToken := pagination.Next(notifications, size, func(notification db.Notification) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: notification.CreatedAt, ID: notification.ID}
+	})
+
+	var pbNotifications []*pb.Notification
+	for _, notification := range notifications {
+		pbNotifications = append(pbNotifications, toNotificationMessage(notification))
+	}
+
+	return &pb.ListNotificationsResponse{
+		Notifications: pbNotifications,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *NotificationHandler) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	query := h.DB.Model(&db.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userIDUint)
+	if len(req.GetIds()) > 0 {
+		query = query.Where("id IN ?", req.GetIds())
+	}
+
+	result := query.Update("read_at", time.Now())
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to mark notifications as read")
+	}
+
+	return &pb.MarkNotificationsReadResponse{
+		UpdatedCount: uint32(result.RowsAffected),
+	}, nil
+}
+
+func (h *NotificationHandler) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	result := h.DB.Model(&db.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userIDUint).
+		Count(&count)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to count notifications")
+	}
+
+	return &pb.GetUnreadCountResponse{
+		UnreadCount: uint32(count),
+	}, nil
+}
+
+func (h *NotificationHandler) Subscribe(req *pb.SubscribeRequest, stream pb.NotificationService_SubscribeServer) error {
+	userID, err := ExtractUserIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if len(req.GetWatchPostIds()) > maxWatchedPosts {
+		return status.Errorf(codes.InvalidArgument, "you can watch up to %d posts", maxWatchedPosts)
+	}
+
+	// 볼 수 없는 게시글의 댓글 이벤트는 구독할 수 없다
+	topics := []string{pubsub.UserTopic(uint(userIDUint))}
+	for _, postID := range req.GetWatchPostIds() {
+		if _, err := findVisiblePost(h.DB, uint(userIDUint), uint(postID)); err != nil {
+			return err
+		}
+		topics = append(topics, pubsub.PostTopic(uint(postID)))
+	}
+
+	messages, cancel, err := h.Broker.Subscribe(topics)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to subscribe")
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription is closed")
+			}
+
+			var event pb.Event
+			if err := proto.Unmarshal(message.Data, &event); err != nil {
+				log.Printf("failed to unmarshal event from %s: %v", message.Topic, err)
+				continue
+			}
+
+			// 댓글 목록과 마찬가지로 차단 관계이거나 뮤트한 사용자의 댓글은 보내지 않는다
+			if comment := event.GetComment(); comment != nil {
+				authorID := uint(comment.GetUserId())
+				if isBlocked(h.DB, uint(userIDUint), authorID) || isMuted(h.DB, uint(userIDUint), authorID) {
+					continue
+				}
+			}
+
+			if err := stream.Send(&event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toNotificationMessage(notification db.Notification) *pb.Notification {
+	var postID, commentID uint32
+	if notification.PostID != nil {
+		postID = uint32(*notification.PostID)
+	}
+	if notification.CommentID != nil {
+		commentID = uint32(*notification.CommentID)
+	}
+
+	return &pb.Notification{
+		Id:        uint32(notification.ID),
+		Type:      notificationTypes[notification.Type],
+		ActorId:   uint32(notification.ActorID),
+		ActorName: notification.Actor.Name,
+		PostId:    postID,
+		CommentId: commentID,
+		Read:      notification.ReadAt != nil,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+}
+
+// 알림을 발생시킨 쓰기 작업과 같은 트랜잭션(tx) 안에서 호출해야 한다
+// 자기 자신이나 차단 관계인 사용자에게는 알림을 보내지 않는다
+func createNotification(tx *gorm.DB, notification db.Notification) error {
+	if notification.UserID == notification.ActorID {
+		return nil
+	}
+
+	if isBlocked(tx, notification.UserID, notification.ActorID) {
+		return nil
+	}
+
+	if err := tx.Omit("Actor").Create(&notification).Error; err != nil {
+		return err
+	}
+
+	if box := outboxFrom(tx); box != nil {
+		box.notifications = append(box.notifications, notification)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	mailer "github.com/YehyeokBang/Simple-SNS/pkg/mail"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72 // bcrypt 는 72바이트까지만 사용한다
+
+	passwordResetTokenTTL = 30 * time.Minute
+)
+
+func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var user db.User
+	result := h.DB.Where("id = ?", userIDUint).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if !auth.CheckPasswordHash(req.GetCurrentPassword(), user.Password) {
+		return nil, status.Error(codes.PermissionDenied, "current password is not correct")
+	}
+
+	if err := validatePassword(req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := auth.HashPassword(req.GetNewPassword())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+
+	var sessionIDs []uint
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Update("password", hashedPassword).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&db.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL AND id <> ?", user.ID, currentSessionID(ctx)).
+			Pluck("id", &sessionIDs).Error
+		if err != nil {
+			return err
+		}
+
+		return revokeSessions(tx, sessionIDs)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+
+	return &pb.ChangePasswordResponse{
+		RevokedSessionCount: uint32(len(sessionIDs)),
+	}, nil
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	var user db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&user)
+	if result.Error != nil || user.Email == nil {
+		return &pb.RequestPasswordResetResponse{}, nil
+	}
+
+	token, err := auth.RandomToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		// 새 토큰을 발급하면 이전에 보낸 토큰은 쓸 수 없다
+		err := tx.Model(&db.PasswordResetToken{}).
+			Where("user_id = ? AND used_at IS NULL", user.ID).
+			Update("used_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&db.PasswordResetToken{
+			UserID:    user.ID,
+			TokenHash: auth.HashToken(token),
+			ExpiresAt: time.Now().Add(passwordResetTokenTTL),
+		}).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	// 응답 시간으로 가입 여부를 알 수 없도록 메일은 응답과 별개로 보낸다
+	message := mailer.Message{
+		To:      *user.Email,
+		Subject: "Simple-SNS password reset",
+		Body: fmt.Sprintf("Use the token below to reset your password. It expires in %d minutes.\n\n%s\n",
+			int(passwordResetTokenTTL.Minutes()), token),
+	}
+	send := func() {
+		if err := h.Mailer.Send(message); err != nil {
+			log.Printf("failed to send password reset mail to user %d: %v", user.ID, err)
+		}
+	}
+	if h.syncMail {
+		send()
+	} else {
+		go send()
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (h *UserHandler) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetRequest) (*pb.ConfirmPasswordResetResponse, error) {
+	if err := validatePassword(req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := auth.HashPassword(req.GetNewPassword())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		var resetToken db.PasswordResetToken
+		result := tx.Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", auth.HashToken(req.GetToken()), time.Now()).
+			First(&resetToken)
+		if result.Error != nil {
+			return status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+		}
+
+		// 동시에 같은 토큰으로 요청이 와도 한 번만 사용되도록 조건부로 갱신한다
+		result = tx.Model(&resetToken).
+			Where("used_at IS NULL").
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+		}
+
+		err := tx.Model(&db.User{}).
+			Where("id = ?", resetToken.UserID).
+			Update("password", hashedPassword).Error
+		if err != nil {
+			return err
+		}
+
+		var sessionIDs []uint
+		err = tx.Model(&db.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", resetToken.UserID).
+			Pluck("id", &sessionIDs).Error
+		if err != nil {
+			return err
+		}
+
+		return revokeSessions(tx, sessionIDs)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	return &pb.ConfirmPasswordResetResponse{}, nil
+}
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return status.Errorf(codes.InvalidArgument, "password must be %d to %d bytes", minPasswordLength, maxPasswordLength)
+	}
+
+	return nil
+}
+
+// 빈 문자열이면 nil 을 반환한다, 메일 주소는 소문자로 저장한다
+func normalizeEmail(email string) (*string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, nil
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || len(email) > maxEmailLength {
+		return nil, status.Error(codes.InvalidArgument, "email is not valid")
+	}
+
+	return &email, nil
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/mail"
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testPassword = "old-password"
+
+func newPasswordTestHandler(t *testing.T) (*UserHandler, *mail.MemoryMailer) {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	gormDB, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	err = gormDB.AutoMigrate(&db.User{}, &db.Session{}, &db.RefreshToken{}, &db.PasswordResetToken{})
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	mailer := mail.NewMemoryMailer()
+	return &UserHandler{DB: gormDB, Mailer: mailer, syncMail: true}, mailer
+}
+
+func createPasswordTestUser(t *testing.T, h *UserHandler, userID, email string) db.User {
+	t.Helper()
+
+	hashedPassword, err := auth.HashPassword(testPassword)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	user := db.User{UserId: userID, Password: hashedPassword, Email: &email}
+	if err := h.DB.Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	return user
+}
+
+// 메일 본문의 마지막 줄이 토큰이다
+func resetTokenFrom(t *testing.T, message mail.Message) string {
+	t.Helper()
+
+	lines := strings.Split(strings.TrimSpace(message.Body), "\n")
+	token := strings.TrimSpace(lines[len(lines)-1])
+	if token == "" {
+		t.Fatalf("mail body has no token: %q", message.Body)
+	}
+
+	return token
+}
+
+func requestResetToken(t *testing.T, h *UserHandler, mailer *mail.MemoryMailer, userID string) string {
+	t.Helper()
+
+	sent := len(mailer.Messages())
+	if _, err := h.RequestPasswordReset(context.Background(), &pb.RequestPasswordResetRequest{UserId: userID}); err != nil {
+		t.Fatalf("RequestPasswordReset returned error: %v", err)
+	}
+
+	// syncMail 이므로 응답을 받았을 때 메일은 이미 보내져 있다
+	messages := mailer.Messages()
+	if len(messages) != sent+1 {
+		t.Fatalf("got %d mails, want %d", len(messages), sent+1)
+	}
+	return resetTokenFrom(t, messages[len(messages)-1])
+}
+
+func confirmReset(h *UserHandler, token, password string) error {
+	_, err := h.ConfirmPasswordReset(context.Background(), &pb.ConfirmPasswordResetRequest{
+		Token:       token,
+		NewPassword: password,
+	})
+	return err
+}
+
+func assertInvalidToken(t *testing.T, err error) {
+	t.Helper()
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+}
+
+func assertPassword(t *testing.T, h *UserHandler, userID uint, password string) {
+	t.Helper()
+
+	var user db.User
+	if err := h.DB.First(&user, userID).Error; err != nil {
+		t.Fatalf("failed to load user: %v", err)
+	}
+	if !auth.CheckPasswordHash(password, user.Password) {
+		t.Fatalf("password of user %d is not %q", userID, password)
+	}
+}
+
+func TestRequestPasswordResetIssuesToken(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	user := createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	token := requestResetToken(t, h, mailer, "alice")
+
+	message := mailer.Messages()[0]
+	if message.To != "alice@example.com" {
+		t.Errorf("mail is sent to %q, want alice@example.com", message.To)
+	}
+
+	// 원문은 저장하지 않고 해시만 남긴다
+	var resetToken db.PasswordResetToken
+	if err := h.DB.Where("user_id = ?", user.ID).First(&resetToken).Error; err != nil {
+		t.Fatalf("reset token is not stored: %v", err)
+	}
+	if resetToken.TokenHash != auth.HashToken(token) || resetToken.TokenHash == token {
+		t.Errorf("stored token hash = %q, want hash of the mailed token", resetToken.TokenHash)
+	}
+	if resetToken.UsedAt != nil {
+		t.Errorf("new token is already used")
+	}
+	if ttl := time.Until(resetToken.ExpiresAt); ttl <= 0 || ttl > passwordResetTokenTTL {
+		t.Errorf("token expires in %v, want within %v", ttl, passwordResetTokenTTL)
+	}
+}
+
+func TestRequestPasswordResetUnknownUser(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	// 가입 여부를 알 수 없도록 존재하지 않는 사용자도 같은 응답을 받는다
+	if _, err := h.RequestPasswordReset(context.Background(), &pb.RequestPasswordResetRequest{UserId: "bob"}); err != nil {
+		t.Fatalf("RequestPasswordReset returned error: %v", err)
+	}
+
+	if messages := mailer.Messages(); len(messages) != 0 {
+		t.Fatalf("sent %d mails for unknown user", len(messages))
+	}
+
+	var count int64
+	h.DB.Model(&db.PasswordResetToken{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("created %d tokens for unknown user", count)
+	}
+}
+
+func TestConfirmPasswordResetSingleUse(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	user := createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	token := requestResetToken(t, h, mailer, "alice")
+
+	if err := confirmReset(h, token, "new-password"); err != nil {
+		t.Fatalf("ConfirmPasswordReset returned error: %v", err)
+	}
+	assertPassword(t, h, user.ID, "new-password")
+
+	assertInvalidToken(t, confirmReset(h, token, "another-password"))
+	assertPassword(t, h, user.ID, "new-password")
+}
+
+func TestConfirmPasswordResetNewTokenReplacesOld(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	user := createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	oldToken := requestResetToken(t, h, mailer, "alice")
+	newToken := requestResetToken(t, h, mailer, "alice")
+
+	assertInvalidToken(t, confirmReset(h, oldToken, "new-password"))
+	assertPassword(t, h, user.ID, testPassword)
+
+	if err := confirmReset(h, newToken, "new-password"); err != nil {
+		t.Fatalf("ConfirmPasswordReset returned error: %v", err)
+	}
+	assertPassword(t, h, user.ID, "new-password")
+}
+
+func TestConfirmPasswordResetExpired(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	user := createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	token := requestResetToken(t, h, mailer, "alice")
+	h.DB.Model(&db.PasswordResetToken{}).
+		Where("user_id = ?", user.ID).
+		Update("expires_at", time.Now().Add(-time.Minute))
+
+	assertInvalidToken(t, confirmReset(h, token, "new-password"))
+	assertPassword(t, h, user.ID, testPassword)
+}
+
+func TestConfirmPasswordResetValidation(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	user := createPasswordTestUser(t, h, "alice", "alice@example.com")
+
+	token := requestResetToken(t, h, mailer, "alice")
+
+	tests := []struct {
+		name     string
+		token    string
+		password string
+	}{
+		{name: "unknown token", token: "not-a-token", password: "new-password"},
+		{name: "short password", token: token, password: "short"},
+		{name: "long password", token: token, password: strings.Repeat("a", maxPasswordLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertInvalidToken(t, confirmReset(h, tt.token, tt.password))
+		})
+	}
+
+	// 검증에 실패한 요청은 토큰을 소모하지 않는다
+	if err := confirmReset(h, token, "new-password"); err != nil {
+		t.Fatalf("ConfirmPasswordReset returned error: %v", err)
+	}
+	assertPassword(t, h, user.ID, "new-password")
+}
+
+func TestConfirmPasswordResetRevokesSessions(t *testing.T) {
+	h, mailer := newPasswordTestHandler(t)
+	alice := createPasswordTestUser(t, h, "alice", "alice@example.com")
+	bob := createPasswordTestUser(t, h, "bob", "bob@example.com")
+
+	sessions := []db.Session{{UserID: alice.ID}, {UserID: alice.ID}, {UserID: bob.ID}}
+	if err := h.DB.Create(&sessions).Error; err != nil {
+		t.Fatalf("failed to create sessions: %v", err)
+	}
+	for i, session := range sessions {
+		err := h.DB.Create(&db.RefreshToken{
+			UserID:    session.UserID,
+			SessionID: session.ID,
+			TokenHash: auth.HashToken(fmt.Sprintf("refresh-%d", i)),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}).Error
+		if err != nil {
+			t.Fatalf("failed to create refresh token: %v", err)
+		}
+	}
+
+	token := requestResetToken(t, h, mailer, "alice")
+	if err := confirmReset(h, token, "new-password"); err != nil {
+		t.Fatalf("ConfirmPasswordReset returned error: %v", err)
+	}
+
+	for _, session := range sessions {
+		var got db.Session
+		h.DB.First(&got, session.ID)
+		var refreshToken db.RefreshToken
+		h.DB.Where("session_id = ?", session.ID).First(&refreshToken)
+
+		wantRevoked := session.UserID == alice.ID
+		if (got.RevokedAt != nil) != wantRevoked {
+			t.Errorf("session %d of user %d revoked = %v, want %v", session.ID, session.UserID, got.RevokedAt != nil, wantRevoked)
+		}
+		if (refreshToken.RevokedAt != nil) != wantRevoked {
+			t.Errorf("refresh token of session %d revoked = %v, want %v", session.ID, refreshToken.RevokedAt != nil, wantRevoked)
+		}
+	}
+}
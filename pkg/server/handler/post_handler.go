@@ -2,27 +2,43 @@ package handler
 
 import (
 	"context"
+	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
 	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
 	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
+	"github.com/YehyeokBang/Simple-SNS/pkg/timeline"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+// db.Post 의 컬럼 크기
+const (
+	maxTitleLength   = 100
+	maxContentLength = 500
+)
+
 type PostHandler struct {
 	pb.UnimplementedPostServiceServer
-	DB  *gorm.DB
-	JWT *auth.JWT
+	DB       *gorm.DB
+	JWT      *auth.JWT
+	Timeline timeline.Strategy
+	Broker   pubsub.Broker
 }
 
-func NewPostHandler(db *gorm.DB, jwt *auth.JWT) *PostHandler {
+func NewPostHandler(db *gorm.DB, jwt *auth.JWT, timeline timeline.Strategy, broker pubsub.Broker) *PostHandler {
 	return &PostHandler{
-		DB:  db,
-		JWT: jwt,
+		DB:       db,
+		JWT:      jwt,
+		Timeline: timeline,
+		Broker:   broker,
 	}
 }
 
@@ -37,17 +53,51 @@ func (h *PostHandler) WritePost(ctx context.Context, req *pb.WritePostRequest) (
 		return nil, err
 	}
 
+	visibility := db.VisibilityPublic
+	if req.GetVisibility() != pb.PostVisibility_POST_VISIBILITY_UNSPECIFIED {
+		name, ok := postVisibilityNames[req.GetVisibility()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "visibility is not valid")
+		}
+		visibility = name
+	}
+
 	post := db.Post{
-		UserID:  uint(userIDUint),
-		Title:   req.GetTitle(),
-		Content: req.GetContent(),
+		UserID:     uint(userIDUint),
+		Title:      req.GetTitle(),
+		Content:    req.GetContent(),
+		Visibility: visibility,
 	}
 
-	result := h.DB.Create(&post)
-	if result.Error != nil {
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&post).Error; err != nil {
+			return err
+		}
+
+		if err := syncPostTags(tx, &post); err != nil {
+			return err
+		}
+
+		if _, err := syncMentions(tx, post.UserID, post.Content, post.ID, nil); err != nil {
+			return err
+		}
+
+		if err := attachMedia(tx, post.UserID, post.ID, req.GetMediaIds()); err != nil {
+			return err
+		}
+
+		return h.Timeline.PostCreated(tx, &post)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, "failed to write post")
 	}
 
+	publishOutbox(h.DB, h.Broker, box)
+
 	h.DB.Joins("User").First(&post)
 
 	return &pb.WritePostResponse{
@@ -55,47 +105,126 @@ func (h *PostHandler) WritePost(ctx context.Context, req *pb.WritePostRequest) (
 	}, nil
 }
 
-func Paginate(req *pb.GetPostsRequest) func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		if req.GetPage() != 0 {
-			offset := int((req.GetPage() - 1) * req.GetLimit())
-			return db.Offset(offset).Limit(int(req.GetLimit()))
-		}
-		return db
+func (h *PostHandler) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.GetPostsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (h *PostHandler) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.GetPostsResponse, error) {
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
 	var posts []db.Post
-	result := h.DB.Scopes(Paginate(req)).
+	result := h.DB.Scopes(visiblePosts(viewerID), withoutMuted("posts", viewerID), pagination.Scope("posts", cursor, size)).
 		Preload("User").
-		Preload("Comments").
-		Order("created_at desc").
+		Preload("Media").
 		Find(&posts)
 
 	if result.Error != nil {
 		return nil, status.Error(codes.Internal, "failed to get posts")
 	}
 
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
+
+	return &pb.GetPostsResponse{
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(viewerID, posts), h.commentCounts(viewerID, posts)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *PostHandler) GetHomeTimeline(ctx context.Context, req *pb.GetHomeTimelineRequest) (*pb.GetHomeTimelineResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var posts []db.Post
+	result := h.DB.Scopes(h.Timeline.Scope(uint(userIDUint)), visiblePosts(uint(userIDUint)), withoutMuted("posts", uint(userIDUint)), pagination.Scope("posts", cursor, size)).
+		Preload("User").
+		Preload("Media").
+		Find(&posts)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get home timeline")
+	}
+
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
+
+	return &pb.GetHomeTimelineResponse{
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(uint(userIDUint), posts), h.commentCounts(uint(userIDUint), posts)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func postCursor(post db.Post) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: post.CreatedAt, ID: post.ID}
+}
+
+// 게시글별로 viewerID 가 볼 수 있는 댓글 수를 한 번의 쿼리로 센다
+func (h *PostHandler) commentCounts(viewerID uint, posts []db.Post) map[uint]int64 {
+	counts := make(map[uint]int64)
+	if len(posts) == 0 {
+		return counts
+	}
+
+	postIDs := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+	}
+
+	var rows []struct {
+		PostID uint
+		Count  int64
+	}
+	h.DB.Model(&db.Comment{}).
+		Scopes(visibleComments(viewerID)).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id").
+		Scan(&rows)
+
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+
+	return counts
+}
+
+func toPostSummaries(posts []db.Post, liked map[uint]bool, commentCounts map[uint]int64) []*pb.PostSummary {
 	var pbPosts []*pb.PostSummary
 	for _, post := range posts {
-		commentCount := len(post.Comments)
 		pbPosts = append(pbPosts, &pb.PostSummary{
 			Id:           uint32(post.ID),
 			UserName:     post.User.Name,
 			Title:        post.Title,
-			CommentCount: uint32(commentCount),
+			CommentCount: uint32(commentCounts[post.ID]),
 			CreatedAt:    timestamppb.New(post.CreatedAt),
 			UpdatedAt:    timestamppb.New(post.UpdatedAt),
+			LikeCount:    uint32(post.LikeCount),
+			LikedByMe:    liked[post.ID],
+			Visibility:   postVisibilities[post.Visibility],
+			Media:        toMediaMessages(post.Media),
 		})
 	}
 
-	return &pb.GetPostsResponse{
-		PostSummaries: pbPosts,
-	}, nil
+	return pbPosts
 }
 
-func sortComments(comments []*pb.Comment) []*pb.Comment {
+func sortComments(comments []*pb.Comment, order pb.CommentSort) []*pb.Comment {
 	normalComments := make([]*pb.Comment, 0)
 	replyComments := make(map[uint32][]*pb.Comment)
 
@@ -107,6 +236,28 @@ func sortComments(comments []*pb.Comment) []*pb.Comment {
 		}
 	}
 
+	sort.SliceStable(normalComments, func(i, j int) bool {
+		a, b := normalComments[i], normalComments[j]
+		switch order {
+		case pb.CommentSort_COMMENT_SORT_NEWEST:
+			return a.Id > b.Id
+		case pb.CommentSort_COMMENT_SORT_MOST_LIKED:
+			if a.LikeCount != b.LikeCount {
+				return a.LikeCount > b.LikeCount
+			}
+			return a.Id < b.Id
+		default:
+			return a.Id < b.Id
+		}
+	})
+
+	// 대댓글은 정렬 기준과 관계없이 작성 순서대로 부모 댓글 아래에 둔다
+	for _, replies := range replyComments {
+		sort.SliceStable(replies, func(i, j int) bool {
+			return replies[i].Id < replies[j].Id
+		})
+	}
+
 	sortedComments := make([]*pb.Comment, 0)
 	for _, comment := range normalComments {
 		sortedComments = append(sortedComments, comment)
@@ -117,72 +268,120 @@ func sortComments(comments []*pb.Comment) []*pb.Comment {
 }
 
 func (h *PostHandler) SearchPostsByTitle(ctx context.Context, req *pb.SearchPostsRequest) (*pb.GetPostsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
 	var posts []db.Post
 	result := h.DB.Where("title LIKE ?", "%"+req.GetKeyword()+"%").
+		Scopes(visiblePosts(viewerID), withoutMuted("posts", viewerID), pagination.Scope("posts", cursor, size)).
 		Preload("User").
-		Preload("Comments").
-		Order("created_at desc").
+		Preload("Media").
 		Find(&posts)
 
 	if result.Error != nil {
 		return nil, status.Error(codes.Internal, "failed to search posts")
 	}
 
-	var pbPosts []*pb.PostSummary
-	for _, post := range posts {
-		commentCount := len(post.Comments)
-		pbPosts = append(pbPosts, &pb.PostSummary{
-			Id:           uint32(post.ID),
-			UserName:     post.User.Name,
-			Title:        post.Title,
-			CommentCount: uint32(commentCount),
-			CreatedAt:    timestamppb.New(post.CreatedAt),
-			UpdatedAt:    timestamppb.New(post.UpdatedAt),
-		})
-	}
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
 
 	return &pb.GetPostsResponse{
-		PostSummaries: pbPosts,
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(viewerID, posts), h.commentCounts(viewerID, posts)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (h *PostHandler) SearchPostsByWriter(ctx context.Context, req *pb.SearchPostsRequest) (*pb.GetPostsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
 	var posts []db.Post
 	result := h.DB.Joins("User").
 		Where("User.name LIKE ?", "%"+req.GetKeyword()+"%").
+		Scopes(visiblePosts(viewerID), withoutMuted("posts", viewerID), pagination.Scope("posts", cursor, size)).
 		Preload("User").
-		Preload("Comments").
-		Order("created_at desc").
+		Preload("Media").
 		Find(&posts)
 
 	if result.Error != nil {
-		println(result.Error)
 		return nil, status.Error(codes.Internal, "failed to search posts")
 	}
 
-	var pbPosts []*pb.PostSummary
-	for _, post := range posts {
-		commentCount := len(post.Comments)
-		pbPosts = append(pbPosts, &pb.PostSummary{
-			Id:           uint32(post.ID),
-			UserName:     post.User.Name,
-			Title:        post.Title,
-			CommentCount: uint32(commentCount),
-			CreatedAt:    timestamppb.New(post.CreatedAt),
-			UpdatedAt:    timestamppb.New(post.UpdatedAt),
-		})
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
+
+	return &pb.GetPostsResponse{
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(viewerID, posts), h.commentCounts(viewerID, posts)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *PostHandler) ListUserPosts(ctx context.Context, req *pb.ListUserPostsRequest) (*pb.GetPostsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var writer db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&writer)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	var posts []db.Post
+	result = h.DB.Scopes(visiblePosts(viewerID), pagination.Scope("posts", cursor, size)).
+		Where("posts.user_id = ?", writer.ID).
+		Preload("User").
+		Preload("Media").
+		Find(&posts)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get posts")
+	}
+
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
 
 	return &pb.GetPostsResponse{
-		PostSummaries: pbPosts,
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(viewerID, posts), h.commentCounts(viewerID, posts)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (h *PostHandler) GetPostById(ctx context.Context, req *pb.GetPostByIdRequest) (*pb.GetPostByIdResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var post db.Post
-	result := h.DB.Joins("User").
-		Preload("Comments").
+	result := h.DB.Scopes(visiblePosts(viewerID)).
+		Joins("User").
+		Preload("Comments", visibleComments(viewerID)).
 		Preload("Comments.User").
+		Preload("Comments.Mentions.User").
+		Preload("Tags").
+		Preload("Media").
+		Preload("Mentions.User").
 		First(&post, req.GetId())
 
 	if result.Error != nil {
@@ -206,18 +405,33 @@ func (h *PostHandler) GetPostById(ctx context.Context, req *pb.GetPostByIdReques
 			UserName:  comment.User.Name,
 			CreatedAt: timestamppb.New(comment.CreatedAt),
 			UpdatedAt: timestamppb.New(comment.UpdatedAt),
+			LikeCount: uint32(comment.LikeCount),
+			Mentions:  toPostMentions(comment.Mentions),
 		})
 	}
 
+	liked := h.likedPostIDs(viewerID, []db.Post{post})
+
+	tags := make([]string, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		tags = append(tags, tag.Name)
+	}
+
 	return &pb.GetPostByIdResponse{
 		Post: &pb.Post{
-			Id:        uint32(post.ID),
-			UserName:  post.User.Name,
-			Title:     post.Title,
-			Content:   post.Content,
-			Comments:  sortComments(pbComments),
-			CreatedAt: timestamppb.New(post.CreatedAt),
-			UpdatedAt: timestamppb.New(post.UpdatedAt),
+			Id:         uint32(post.ID),
+			UserName:   post.User.Name,
+			Title:      post.Title,
+			Content:    post.Content,
+			Comments:   sortComments(pbComments, req.GetCommentSort()),
+			CreatedAt:  timestamppb.New(post.CreatedAt),
+			UpdatedAt:  timestamppb.New(post.UpdatedAt),
+			LikeCount:  uint32(post.LikeCount),
+			LikedByMe:  liked[post.ID],
+			Tags:       tags,
+			Mentions:   toPostMentions(post.Mentions),
+			Visibility: postVisibilities[post.Visibility],
+			Media:      toMediaMessages(post.Media),
 		},
 	}, nil
 }
@@ -239,23 +453,61 @@ func (h *PostHandler) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest)
 		return nil, status.Error(codes.NotFound, "post is not exists")
 	}
 
-	if post.UserID != uint(userIDUint) {
+	if !canModify(ctx, post.UserID, uint(userIDUint)) {
 		return nil, status.Error(codes.PermissionDenied, "you don't have permission")
 	}
 
-	if req.GetTitle() != "" {
-		post.Title = req.GetTitle()
+	paths, err := updateMaskPaths(req.GetUpdateMask(), req, "title", "content", "visibility")
+	if err != nil {
+		return nil, err
 	}
 
-	if req.GetContent() != "" {
-		post.Content = req.GetContent()
+	for _, path := range paths {
+		switch path {
+		case "title":
+			if strings.TrimSpace(req.GetTitle()) == "" {
+				return nil, status.Error(codes.InvalidArgument, "title can't be empty")
+			}
+			if utf8.RuneCountInString(req.GetTitle()) > maxTitleLength {
+				return nil, status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxTitleLength)
+			}
+			post.Title = req.GetTitle()
+		case "content":
+			if utf8.RuneCountInString(req.GetContent()) > maxContentLength {
+				return nil, status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxContentLength)
+			}
+			post.Content = req.GetContent()
+		case "visibility":
+			visibility, ok := postVisibilityNames[req.GetVisibility()]
+			if !ok {
+				return nil, status.Error(codes.InvalidArgument, "visibility is not valid")
+			}
+			post.Visibility = visibility
+		}
 	}
 
-	result = h.DB.Save(&post)
-	if result.Error != nil {
+	ctx, box := withOutbox(ctx)
+	err = h.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&post).Error; err != nil {
+			return err
+		}
+
+		if err := syncPostTags(tx, &post); err != nil {
+			return err
+		}
+
+		if _, err := syncMentions(tx, post.UserID, post.Content, post.ID, nil); err != nil {
+			return err
+		}
+
+		return recordModeratorEdit(tx, post.UserID, uint(userIDUint), db.ModerationActionEditPost, db.ReportTargetPost, post.ID)
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to update post")
 	}
 
+	publishOutbox(h.DB, h.Broker, box)
+
 	var loadedPost db.Post
 	h.DB.Preload("User").First(&loadedPost, post.ID)
 
@@ -281,12 +533,18 @@ func (h *PostHandler) DeletePost(ctx context.Context, req *pb.DeletePostRequest)
 		return nil, status.Error(codes.NotFound, "post is not exists")
 	}
 
-	if post.UserID != uint(userIDUint) {
+	if !canModify(ctx, post.UserID, uint(userIDUint)) {
 		return nil, status.Error(codes.PermissionDenied, "you don't have permission")
 	}
 
-	result = h.DB.Delete(&post)
-	if result.Error != nil {
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&post).Error; err != nil {
+			return err
+		}
+
+		return recordModeratorEdit(tx, post.UserID, uint(userIDUint), db.ModerationActionDeletePost, db.ReportTargetPost, post.ID)
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to delete post")
 	}
 
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var fieldVisibilities = map[string]pb.FieldVisibility{
+	db.VisibilityPublic:    pb.FieldVisibility_FIELD_VISIBILITY_PUBLIC,
+	db.VisibilityFollowers: pb.FieldVisibility_FIELD_VISIBILITY_FOLLOWERS,
+	db.VisibilityPrivate:   pb.FieldVisibility_FIELD_VISIBILITY_PRIVATE,
+}
+
+var fieldVisibilityNames = map[pb.FieldVisibility]string{
+	pb.FieldVisibility_FIELD_VISIBILITY_PUBLIC:    db.VisibilityPublic,
+	pb.FieldVisibility_FIELD_VISIBILITY_FOLLOWERS: db.VisibilityFollowers,
+	pb.FieldVisibility_FIELD_VISIBILITY_PRIVATE:   db.VisibilityPrivate,
+}
+
+func (h *UserHandler) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.GetUserProfileResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var user db.User
+	result := h.DB.Where("user_id = ?", req.GetUserId()).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	owner := user.ID == viewerID
+	follower := !owner && viewerID != anonymousViewer && isFollowing(h.DB, viewerID, user.ID)
+
+	profile := &pb.UserProfile{
+		UserId:       user.UserId,
+		Name:         user.Name,
+		Introduce:    user.Introduce,
+		FollowedByMe: follower,
+		CreatedAt:    timestamppb.New(user.CreatedAt),
+	}
+
+	if visibleTo(user.AgeVisibility, owner, follower) {
+		profile.Age = user.Age
+	}
+	if visibleTo(user.SexVisibility, owner, follower) {
+		profile.Sex = user.Sex
+	}
+	if visibleTo(user.BirthdayVisibility, owner, follower) && user.Birthday != nil {
+		profile.Birthday = timestamppb.New(*user.Birthday)
+	}
+
+	var postCount, followerCount, followingCount int64
+	h.DB.Model(&db.Post{}).Scopes(visiblePosts(viewerID)).Where("posts.user_id = ?", user.ID).Count(&postCount)
+	h.DB.Model(&db.Follow{}).Where("following_id = ?", user.ID).Count(&followerCount)
+	h.DB.Model(&db.Follow{}).Where("follower_id = ?", user.ID).Count(&followingCount)
+
+	profile.PostCount = uint32(postCount)
+	profile.FollowerCount = uint32(followerCount)
+	profile.FollowingCount = uint32(followingCount)
+
+	return &pb.GetUserProfileResponse{
+		Profile: profile,
+	}, nil
+}
+
+func (h *UserHandler) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	prefix := escapeLike(query) + "%"
+
+	var users []db.User
+	result := h.DB.Scopes(pagination.Scope("users", cursor, size)).
+		Where("user_id LIKE ? OR name LIKE ?", prefix, prefix).
+		Find(&users)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to search users")
+	}
+
+	users, nextPageToken := pagination.Next(users, size, func(user db.User) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}
+	})
+
+	var summaries []*pb.UserSummary
+	for _, user := range users {
+		summaries = append(summaries, toUserSummary(user))
+	}
+
+	return &pb.SearchUsersResponse{
+		Users:         summaries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func toProfileVisibility(user db.User) *pb.ProfileVisibility {
+	return &pb.ProfileVisibility{
+		Age:      fieldVisibilities[user.AgeVisibility],
+		Sex:      fieldVisibilities[user.SexVisibility],
+		Birthday: fieldVisibilities[user.BirthdayVisibility],
+	}
+}
+
+// LIKE 패턴에서 검색어의 %, _ 가 와일드카드로 해석되지 않도록 한다
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
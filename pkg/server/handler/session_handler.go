@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func (h *UserHandler) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []db.Session
+	result := h.DB.Where("user_id = ? AND revoked_at IS NULL", userIDUint).
+		Order("last_seen_at desc").
+		Find(&sessions)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get sessions")
+	}
+
+	currentID := currentSessionID(ctx)
+
+	var pbSessions []*pb.Session
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, &pb.Session{
+			Id:         uint32(session.ID),
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			Current:    strconv.FormatUint(uint64(session.ID), 10) == currentID,
+		})
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: pbSessions,
+	}, nil
+}
+
+func (h *UserHandler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var session db.Session
+	result := h.DB.Where("id = ? AND user_id = ? AND revoked_at IS NULL", req.GetSessionId(), userIDUint).
+		First(&session)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "session is not exists")
+	}
+
+	if err := revokeSessions(h.DB, []uint{session.ID}); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func (h *UserHandler) RevokeAllOtherSessions(ctx context.Context, req *pb.RevokeAllOtherSessionsRequest) (*pb.RevokeAllOtherSessionsResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionIDs []uint
+	result := h.DB.Model(&db.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL AND id <> ?", userIDUint, currentSessionID(ctx)).
+		Pluck("id", &sessionIDs)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get sessions")
+	}
+
+	if err := revokeSessions(h.DB, sessionIDs); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke sessions")
+	}
+
+	return &pb.RevokeAllOtherSessionsResponse{
+		RevokedCount: uint32(len(sessionIDs)),
+	}, nil
+}
+
+// 로그인 요청의 user-agent 와 접속 IP 를 기록한다
+func newSession(ctx context.Context, userID uint) db.Session {
+	session := db.Session{
+		UserID:     userID,
+		LastSeenAt: time.Now(),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+			session.UserAgent = userAgent[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		session.IPAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(session.IPAddress); err == nil {
+			session.IPAddress = host
+		}
+	}
+
+	return session
+}
+
+func currentSessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(auth.SessionIDKey).(string)
+	return sessionID
+}
+
+// 세션을 폐기하면 그 세션의 refresh token 도 더 이상 쓸 수 없다
+// access token 은 AuthInterceptor 에서 세션을 확인하므로 바로 거부된다
+func revokeSessions(gormDB *gorm.DB, sessionIDs []uint) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	return gormDB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		err := tx.Model(&db.Session{}).
+			Where("id IN ? AND revoked_at IS NULL", sessionIDs).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&db.RefreshToken{}).
+			Where("session_id IN ? AND revoked_at IS NULL", sessionIDs).
+			Update("revoked_at", now).Error
+	})
+}
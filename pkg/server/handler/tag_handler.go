@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pagination"
+	"github.com/YehyeokBang/Simple-SNS/pkg/parser"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultTrendingWindow = 24 * time.Hour
+	maxTrendingWindow     = 7 * 24 * time.Hour
+	defaultTrendingLimit  = 10
+	maxTrendingLimit      = 100
+)
+
+func (h *PostHandler) SearchPostsByTag(ctx context.Context, req *pb.SearchPostsRequest) (*pb.GetPostsResponse, error) {
+	viewerID, err := ExtractViewerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := pagination.PageSize(req.GetPageSize())
+
+	tag := parser.NormalizeTag(req.GetKeyword())
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag is required")
+	}
+
+	var posts []db.Post
+	result := h.DB.Joins("JOIN post_tags ON post_tags.post_id = posts.id").
+		Joins("JOIN tags ON tags.id = post_tags.tag_id").
+		Where("tags.name = ?", tag).
+		Scopes(visiblePosts(viewerID), withoutMuted("posts", viewerID), pagination.Scope("posts", cursor, size)).
+		Preload("User").
+		Preload("Media").
+		Find(&posts)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to search posts")
+	}
+
+	posts, nextPageToken := pagination.Next(posts, size, postCursor)
+
+	return &pb.GetPostsResponse{
+		PostSummaries: toPostSummaries(posts, h.likedPostIDs(viewerID, posts), h.commentCounts(viewerID, posts)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *PostHandler) ListTrendingTags(ctx context.Context, req *pb.ListTrendingTagsRequest) (*pb.ListTrendingTagsResponse, error) {
+	window := defaultTrendingWindow
+	if req.GetWindowHours() != 0 {
+		window = time.Duration(req.GetWindowHours()) * time.Hour
+	}
+	if window > maxTrendingWindow {
+		window = maxTrendingWindow
+	}
+
+	limit := defaultTrendingLimit
+	if req.GetLimit() != 0 {
+		limit = int(req.GetLimit())
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+
+	var rows []struct {
+		Name      string
+		PostCount int64
+	}
+	result := h.DB.Model(&db.Tag{}).
+		Select("tags.name AS name, COUNT(*) AS post_count").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.delete_at IS NULL").
+		Where("posts.visibility = ? AND posts.hidden_at IS NULL", db.VisibilityPublic).
+		Where("posts.created_at >= ?", time.Now().Add(-window)).
+		Group("tags.id, tags.name").
+		Order("post_count desc").
+		Order("tags.name asc").
+		Limit(limit).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to get trending tags")
+	}
+
+	var tags []*pb.TrendingTag
+	for _, row := range rows {
+		tags = append(tags, &pb.TrendingTag{
+			Name:      row.Name,
+			PostCount: uint32(row.PostCount),
+		})
+	}
+
+	return &pb.ListTrendingTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+// 게시글 본문의 해시태그로 post_tags 를 다시 맞춘다, 게시글을 저장하는 트랜잭션 안에서 호출해야 한다
+func syncPostTags(tx *gorm.DB, post *db.Post) error {
+	names := parser.ExtractHashtags(post.Content)
+
+	tags := make([]db.Tag, 0, len(names))
+	if len(names) > 0 {
+		for _, name := range names {
+			tags = append(tags, db.Tag{Name: name})
+		}
+
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error
+		if err != nil {
+			return err
+		}
+
+		// 이미 존재해서 생성되지 않은 태그는 ID 가 채워지지 않으므로 다시 조회한다
+		tags = tags[:0]
+		if err := tx.Where("name IN ?", names).Find(&tags).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(post).Association("Tags").Replace(tags)
+}
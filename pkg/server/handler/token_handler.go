@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type issuedTokens struct {
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	var tokens issuedTokens
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		var refreshToken db.RefreshToken
+		result := tx.Where("token_hash = ?", auth.HashToken(req.GetRefreshToken())).First(&refreshToken)
+		if result.Error != nil {
+			return status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+
+		if refreshToken.RevokedAt != nil || refreshToken.ExpiresAt.Before(time.Now()) {
+			return status.Error(codes.Unauthenticated, "refresh token is expired or revoked")
+		}
+
+		// 이미 사용된 토큰이 다시 왔다면 탈취된 것으로 보고 세션을 폐기한다
+		result = tx.Model(&refreshToken).
+			Where("used_at IS NULL").
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errRefreshTokenReused{sessionID: refreshToken.SessionID}
+		}
+
+		result = tx.Model(&db.Session{}).
+			Where("id = ?", refreshToken.SessionID).
+			UpdateColumn("last_seen_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+
+		issued, err := h.issueTokens(tx, refreshToken.UserID, refreshToken.SessionID)
+		if err != nil {
+			return err
+		}
+
+		tokens = issued
+		return nil
+	})
+	if reused, ok := err.(errRefreshTokenReused); ok {
+		// 트랜잭션이 롤백되므로 폐기는 트랜잭션 밖에서 한다
+		if err := revokeSessions(h.DB, []uint{reused.sessionID}); err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke refresh token")
+		}
+		return nil, status.Error(codes.Unauthenticated, "refresh token is already used")
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	return &pb.RefreshTokenResponse{
+		JwtToken:     tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresAt:    timestamppb.New(tokens.expiresAt),
+	}, nil
+}
+
+func (h *UserHandler) LogOut(ctx context.Context, req *pb.LogOutRequest) (*pb.LogOutResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionIDs []uint
+	if sessionID, err := strconv.ParseUint(currentSessionID(ctx), 10, 64); err == nil {
+		sessionIDs = append(sessionIDs, uint(sessionID))
+	}
+
+	if req.GetRefreshToken() != "" {
+		var refreshToken db.RefreshToken
+		result := h.DB.Where("token_hash = ? AND user_id = ?", auth.HashToken(req.GetRefreshToken()), userIDUint).
+			First(&refreshToken)
+		if result.Error != nil {
+			return nil, status.Error(codes.NotFound, "refresh token is not exists")
+		}
+
+		sessionIDs = append(sessionIDs, refreshToken.SessionID)
+	}
+
+	if err := revokeSessions(h.DB, sessionIDs); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	jti, _ := ctx.Value(auth.TokenIDKey).(string)
+	if err := h.JWT.RevokeToken(jti); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke access token")
+	}
+
+	return &pb.LogOutResponse{}, nil
+}
+
+// 세션에 속하는 access token 과 refresh token 을 발급한다
+func (h *UserHandler) issueTokens(tx *gorm.DB, userID, sessionID uint) (issuedTokens, error) {
+	// 역할은 발급할 때마다 다시 읽어 최신 값을 토큰에 담는다
+	var user db.User
+	if err := tx.Select("id", "role").First(&user, userID).Error; err != nil {
+		return issuedTokens{}, err
+	}
+
+	accessToken, err := h.JWT.CreateToken(fmt.Sprintf("%d", userID), fmt.Sprintf("%d", sessionID), user.Role)
+	if err != nil {
+		return issuedTokens{}, err
+	}
+
+	token, err := auth.RandomToken()
+	if err != nil {
+		return issuedTokens{}, err
+	}
+
+	refreshToken := db.RefreshToken{
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenHash: auth.HashToken(token),
+		ExpiresAt: time.Now().Add(auth.RefreshTokenTTL),
+	}
+	if err := tx.Create(&refreshToken).Error; err != nil {
+		return issuedTokens{}, err
+	}
+
+	return issuedTokens{
+		accessToken:  accessToken,
+		refreshToken: token,
+		expiresAt:    time.Now().Add(auth.AccessTokenTTL),
+	}, nil
+}
+
+type errRefreshTokenReused struct {
+	sessionID uint
+}
+
+func (e errRefreshTokenReused) Error() string {
+	return "refresh token is already used"
+}
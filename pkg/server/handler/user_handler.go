@@ -2,32 +2,76 @@ package handler
 
 import (
 	"context"
-	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	pb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
 	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
 	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"github.com/YehyeokBang/Simple-SNS/pkg/export"
+	"github.com/YehyeokBang/Simple-SNS/pkg/mail"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
+	"github.com/YehyeokBang/Simple-SNS/pkg/storage"
+	"github.com/YehyeokBang/Simple-SNS/pkg/timeline"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+// 프로필 필드 제한, 문자열 길이는 db.User 의 컬럼 크기를 따른다
+const (
+	maxNameLength      = 100
+	maxIntroduceLength = 100
+	maxSexLength       = 100
+	maxEmailLength     = 255
+	maxAge             = 150
+)
+
+var minBirthday = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
 type UserHandler struct {
 	pb.UnimplementedUserServiceServer
-	DB  *gorm.DB
-	JWT *auth.JWT
+	DB       *gorm.DB
+	JWT      *auth.JWT
+	Timeline timeline.Strategy
+	Broker   pubsub.Broker
+	Mailer   mail.Mailer
+	Exporter *export.Exporter
+	Storage  storage.Storage
+
+	// 메일을 응답 전에 보낸다, 테스트에서 보낸 메일을 바로 확인할 수 있게 한다
+	syncMail bool
 }
 
-func NewUserHandler(db *gorm.DB, jwt *auth.JWT) *UserHandler {
+func NewUserHandler(db *gorm.DB, jwt *auth.JWT, timeline timeline.Strategy, broker pubsub.Broker, mailer mail.Mailer, exporter *export.Exporter, storage storage.Storage) *UserHandler {
 	return &UserHandler{
-		DB:  db,
-		JWT: jwt,
+		DB:       db,
+		JWT:      jwt,
+		Timeline: timeline,
+		Broker:   broker,
+		Mailer:   mailer,
+		Exporter: exporter,
+		Storage:  storage,
 	}
 }
 
 func (h *UserHandler) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.SignUpResponse, error) {
+	email, err := normalizeEmail(req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	if email != nil {
+		var count int64
+		h.DB.Model(&db.User{}).Where("email = ?", *email).Count(&count)
+		if count > 0 {
+			return nil, status.Error(codes.AlreadyExists, "email is already in use")
+		}
+	}
+
 	hashedPassword, err := auth.HashPassword(req.GetPassword())
 	if err != nil {
 		return nil, err
@@ -47,6 +91,7 @@ func (h *UserHandler) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 		Sex:       req.GetSex(),
 		Birthday:  birthday,
 		Introduce: req.GetIntroduce(),
+		Email:     email,
 	}
 
 	result := h.DB.Create(&user)
@@ -73,19 +118,39 @@ func (h *UserHandler) LogIn(ctx context.Context, req *pb.LogInRequest) (*pb.LogI
 		return nil, status.Error(codes.NotFound, "user is not exists or password is not correct")
 	}
 
-	userID := fmt.Sprintf("%d", user.ID)
-	token, err := h.JWT.CreateToken(userID)
+	if user.SuspendedUntil != nil && user.SuspendedUntil.After(time.Now()) {
+		return nil, status.Errorf(codes.PermissionDenied, "account is suspended until %s", user.SuspendedUntil.Format(time.RFC3339))
+	}
+
+	var tokens issuedTokens
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		session := newSession(ctx, user.ID)
+		if err := tx.Create(&session).Error; err != nil {
+			return err
+		}
+
+		issued, err := h.issueTokens(tx, user.ID, session.ID)
+		if err != nil {
+			return err
+		}
+
+		tokens = issued
+		return nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create token")
 	}
 
 	return &pb.LogInResponse{
-		JwtToken: token,
+		JwtToken:     tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresAt:    timestamppb.New(tokens.expiresAt),
 	}, nil
 }
 
 func ExtractUserIDFromContext(ctx context.Context) (string, error) {
-	userID := ctx.Value(auth.UserIDKey).(string)
+	// 인증이 선택인 RPC 에 토큰 없이 들어오면 값이 비어 있다
+	userID, _ := ctx.Value(auth.UserIDKey).(string)
 	if userID == "" {
 		return "", status.Error(codes.Unauthenticated, "user id is not provided")
 	}
@@ -93,6 +158,34 @@ func ExtractUserIDFromContext(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// 로그인하지 않은 사용자의 viewer ID
+const anonymousViewer uint = 0
+
+// 인증이 선택인 RPC 에서 조회하는 사용자의 ID, 토큰 없이 호출하면 anonymousViewer 를 반환한다
+func ExtractViewerIDFromContext(ctx context.Context) (uint, error) {
+	userID, _ := ctx.Value(auth.UserIDKey).(string)
+	if userID == "" {
+		return anonymousViewer, nil
+	}
+
+	userIDUint, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(userIDUint), nil
+}
+
+// role 클레임이 없는 토큰은 일반 사용자로 본다
+func ExtractRoleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(auth.RoleKey).(string)
+	if role == "" {
+		return db.RoleUser
+	}
+
+	return role
+}
+
 func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	userID, err := ExtractUserIDFromContext(ctx)
 	if err != nil {
@@ -110,12 +203,143 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 		birthday = timestamppb.New(*user.Birthday)
 	}
 
+	var followerCount, followingCount int64
+	h.DB.Model(&db.Follow{}).Where("following_id = ?", user.ID).Count(&followerCount)
+	h.DB.Model(&db.Follow{}).Where("follower_id = ?", user.ID).Count(&followingCount)
+
 	return &pb.GetUserResponse{
-		UserId:    user.UserId,
-		Name:      user.Name,
-		Age:       user.Age,
-		Sex:       user.Sex,
-		Birthday:  birthday,
-		Introduce: user.Introduce,
+		UserId:         user.UserId,
+		Name:           user.Name,
+		Age:            user.Age,
+		Sex:            user.Sex,
+		Birthday:       birthday,
+		Introduce:      user.Introduce,
+		FollowerCount:  uint32(followerCount),
+		FollowingCount: uint32(followingCount),
+		Visibility:     toProfileVisibility(user),
+	}, nil
+}
+
+func (h *UserHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	userID, err := ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := updateMaskPaths(req.GetUpdateMask(), req, "name", "introduce", "birthday", "sex", "age", "email",
+		"visibility", "visibility.age", "visibility.sex", "visibility.birthday")
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	for _, path := range paths {
+		switch path {
+		case "name":
+			name := strings.TrimSpace(req.GetName())
+			if name == "" {
+				return nil, status.Error(codes.InvalidArgument, "name can't be empty")
+			}
+			if utf8.RuneCountInString(name) > maxNameLength {
+				return nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxNameLength)
+			}
+			updates["name"] = name
+		case "introduce":
+			if utf8.RuneCountInString(req.GetIntroduce()) > maxIntroduceLength {
+				return nil, status.Errorf(codes.InvalidArgument, "introduce must be at most %d characters", maxIntroduceLength)
+			}
+			updates["introduce"] = req.GetIntroduce()
+		case "birthday":
+			if req.GetBirthday() == nil {
+				updates["birthday"] = nil
+				break
+			}
+			if err := req.GetBirthday().CheckValid(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "birthday is not valid")
+			}
+			birthday := req.GetBirthday().AsTime()
+			if birthday.After(time.Now()) || birthday.Before(minBirthday) {
+				return nil, status.Error(codes.InvalidArgument, "birthday is out of range")
+			}
+			updates["birthday"] = birthday
+		case "sex":
+			sex := strings.TrimSpace(req.GetSex())
+			if utf8.RuneCountInString(sex) > maxSexLength {
+				return nil, status.Errorf(codes.InvalidArgument, "sex must be at most %d characters", maxSexLength)
+			}
+			updates["sex"] = sex
+		case "age":
+			if req.GetAge() > maxAge {
+				return nil, status.Errorf(codes.InvalidArgument, "age must be at most %d", maxAge)
+			}
+			updates["age"] = req.GetAge()
+		case "email":
+			email, err := normalizeEmail(req.GetEmail())
+			if err != nil {
+				return nil, err
+			}
+			updates["email"] = email
+		case "visibility", "visibility.age", "visibility.sex", "visibility.birthday":
+			visibility := req.GetVisibility()
+			levels := map[string]pb.FieldVisibility{
+				"age":      visibility.GetAge(),
+				"sex":      visibility.GetSex(),
+				"birthday": visibility.GetBirthday(),
+			}
+
+			for field, level := range levels {
+				if path != "visibility" && path != "visibility."+field {
+					continue
+				}
+
+				name, ok := fieldVisibilityNames[level]
+				if !ok {
+					return nil, status.Errorf(codes.InvalidArgument, "visibility.%s is not valid", field)
+				}
+				updates[field+"_visibility"] = name
+			}
+		}
+	}
+
+	var user db.User
+	result := h.DB.Where("id = ?", userID).First(&user)
+	if result.Error != nil {
+		return nil, status.Error(codes.NotFound, "user is not exists")
+	}
+
+	if email, ok := updates["email"].(*string); ok && email != nil {
+		var count int64
+		h.DB.Model(&db.User{}).Where("email = ? AND id <> ?", *email, user.ID).Count(&count)
+		if count > 0 {
+			return nil, status.Error(codes.AlreadyExists, "email is already in use")
+		}
+	}
+
+	result = h.DB.Model(&user).Updates(updates)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to update profile")
+	}
+
+	h.DB.First(&user, user.ID)
+
+	var birthday *timestamppb.Timestamp
+	if user.Birthday != nil {
+		birthday = timestamppb.New(*user.Birthday)
+	}
+
+	var email string
+	if user.Email != nil {
+		email = *user.Email
+	}
+
+	return &pb.UpdateProfileResponse{
+		UserId:     user.UserId,
+		Name:       user.Name,
+		Age:        user.Age,
+		Sex:        user.Sex,
+		Birthday:   birthday,
+		Introduce:  user.Introduce,
+		Email:      email,
+		Visibility: toProfileVisibility(user),
 	}, nil
 }
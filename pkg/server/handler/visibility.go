@@ -0,0 +1,147 @@
+package handler
+
+import (
+	postpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var postVisibilities = map[string]postpb.PostVisibility{
+	db.VisibilityPublic:    postpb.PostVisibility_POST_VISIBILITY_PUBLIC,
+	db.VisibilityFollowers: postpb.PostVisibility_POST_VISIBILITY_FOLLOWERS,
+	db.VisibilityPrivate:   postpb.PostVisibility_POST_VISIBILITY_PRIVATE,
+}
+
+var postVisibilityNames = map[postpb.PostVisibility]string{
+	postpb.PostVisibility_POST_VISIBILITY_PUBLIC:    db.VisibilityPublic,
+	postpb.PostVisibility_POST_VISIBILITY_FOLLOWERS: db.VisibilityFollowers,
+	postpb.PostVisibility_POST_VISIBILITY_PRIVATE:   db.VisibilityPrivate,
+}
+
+// owner 는 조회하는 사용자가 작성자(본인)인지, follower 는 작성자를 팔로우하는지
+func visibleTo(visibility string, owner, follower bool) bool {
+	switch visibility {
+	case db.VisibilityPublic:
+		return true
+	case db.VisibilityFollowers:
+		return owner || follower
+	default:
+		return owner
+	}
+}
+
+func isFollowing(gormDB *gorm.DB, followerID, followingID uint) bool {
+	var count int64
+	gormDB.Model(&db.Follow{}).
+		Where("follower_id = ? AND following_id = ?", followerID, followingID).
+		Count(&count)
+	return count > 0
+}
+
+// viewerID 가 볼 수 있는 게시글만 남기는 posts 조회 scope
+// 게시글을 읽는 모든 쿼리에 적용해야 한다, 차단 관계인 사용자의 게시글도 제외한다
+// 익명 사용자는 전체 공개 게시글만 볼 수 있다
+func visiblePosts(viewerID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if viewerID == anonymousViewer {
+			return tx.Where("posts.visibility = ?", db.VisibilityPublic).Scopes(notHidden("posts"))
+		}
+
+		following := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&db.Follow{}).
+			Select("following_id").
+			Where("follower_id = ?", viewerID)
+
+		return tx.Where("posts.visibility = ? OR posts.user_id = ? OR (posts.visibility = ? AND posts.user_id IN (?))",
+			db.VisibilityPublic, viewerID, db.VisibilityFollowers, following).
+			Scopes(notHidden("posts"), withoutBlocked("posts", viewerID))
+	}
+}
+
+// 뮤트한 사용자의 글을 목록에서 빼는 scope, 게시글을 직접 조회할 때는 적용하지 않는다
+func withoutMuted(table string, viewerID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if viewerID == anonymousViewer {
+			return tx
+		}
+
+		muted := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&db.Mute{}).
+			Select("muted_id").
+			Where("muter_id = ?", viewerID)
+
+		return tx.Where(table+".user_id NOT IN (?)", muted)
+	}
+}
+
+// viewerID 가 차단했거나 viewerID 를 차단한 사용자의 글을 빼는 scope
+func withoutBlocked(table string, viewerID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if viewerID == anonymousViewer {
+			return tx
+		}
+
+		blocked := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&db.Block{}).
+			Select("blocked_id").
+			Where("blocker_id = ?", viewerID)
+		blockers := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&db.Block{}).
+			Select("blocker_id").
+			Where("blocked_id = ?", viewerID)
+
+		return tx.Where(table+".user_id NOT IN (?) AND "+table+".user_id NOT IN (?)", blocked, blockers)
+	}
+}
+
+// 댓글 목록에서 숨겨진 댓글과 차단 관계이거나 뮤트한 사용자의 댓글을 뺀다
+func visibleComments(viewerID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Scopes(notHidden("comments"), withoutBlocked("comments", viewerID), withoutMuted("comments", viewerID))
+	}
+}
+
+// 관리자가 숨긴 글은 작성자를 포함한 누구에게도 보이지 않는다
+func notHidden(table string) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(table + ".hidden_at IS NULL")
+	}
+}
+
+// 어느 한쪽이라도 차단했는지
+func isBlocked(gormDB *gorm.DB, userID, otherID uint) bool {
+	var count int64
+	gormDB.Model(&db.Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count)
+	return count > 0
+}
+
+func isMuted(gormDB *gorm.DB, muterID, mutedID uint) bool {
+	var count int64
+	gormDB.Model(&db.Mute{}).
+		Where("muter_id = ? AND muted_id = ?", muterID, mutedID).
+		Count(&count)
+	return count > 0
+}
+
+// 볼 수 없는 게시글은 존재 여부도 드러나지 않도록 NotFound 를 반환한다
+func findVisiblePost(tx *gorm.DB, viewerID, postID uint) (db.Post, error) {
+	var post db.Post
+	if err := tx.Scopes(visiblePosts(viewerID)).First(&post, postID).Error; err != nil {
+		return post, status.Error(codes.NotFound, "post is not exists")
+	}
+
+	return post, nil
+}
+
+func canViewPost(gormDB *gorm.DB, viewerID uint, post db.Post) bool {
+	if viewerID == anonymousViewer {
+		return post.Visibility == db.VisibilityPublic
+	}
+
+	owner := post.UserID == viewerID
+	return visibleTo(post.Visibility, owner, !owner && isFollowing(gormDB, viewerID, post.UserID))
+}
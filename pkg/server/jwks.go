@@ -0,0 +1,33 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+const jwksPath = "/.well-known/jwks.json"
+
+// 다른 서비스가 비밀 키를 공유하지 않고 토큰을 검증할 수 있도록 공개 키를 내보낸다
+func (s *Server) MustRunJWKSServer(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(jwksPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if err := json.NewEncoder(w).Encode(s.JWT.Keys.JWKS(time.Now())); err != nil {
+			log.Printf("failed to write jwks: %v", err)
+		}
+	})
+
+	log.Printf("[jwks server is running on %s%s]", addr, jwksPath)
+
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("failed to serve jwks: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"fmt"
+
+	authpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/auth"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type authMode int
+
+const (
+	authRequired authMode = iota // 토큰이 반드시 있어야 한다
+	authOptional                 // 토큰이 있으면 검사하고, 없으면 익명으로 호출한다
+	authPublic                   // 토큰을 검사하지 않는다
+)
+
+// methodAuthModes 는 proto 메서드 옵션 (v1.auth.auth) 로 선언된 RPC 별 인증 방식이다
+// key 는 grpc 의 FullMethod 형식("/v1.user.UserService/SignUp"), 옵션이 없는 RPC 는 담지 않는다
+type methodAuthModes map[string]authMode
+
+func (m methodAuthModes) mode(fullMethod string) authMode {
+	return m[fullMethod]
+}
+
+// 등록된 모든 proto 서비스 descriptor 에서 인증 옵션을 읽는다
+// 서버를 띄울 때 한 번만 호출하면 되므로 proto 를 바꾸면 미들웨어를 고치지 않아도 된다
+func loadMethodAuthModes() methodAuthModes {
+	modes := make(methodAuthModes)
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				if mode, ok := methodAuthMode(method); ok {
+					modes[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = mode
+				}
+			}
+		}
+		return true
+	})
+
+	return modes
+}
+
+func methodAuthMode(method protoreflect.MethodDescriptor) (authMode, bool) {
+	options := method.Options()
+	if options == nil || !proto.HasExtension(options, authpb.E_Auth) {
+		return authRequired, false
+	}
+
+	option, ok := proto.GetExtension(options, authpb.E_Auth).(*authpb.MethodAuth)
+	if !ok {
+		return authRequired, false
+	}
+
+	switch {
+	case option.GetPublic():
+		return authPublic, true
+	case option.GetOptional():
+		return authOptional, true
+	default:
+		return authRequired, false
+	}
+}
@@ -11,36 +11,88 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func AuthInterceptor(jwt *auth.JWT) grpc.UnaryServerInterceptor {
+func AuthInterceptor(jwt *auth.JWT, modes methodAuthModes) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if info.FullMethod == "/v1.user.UserService/SignUp" ||
-			info.FullMethod == "/v1.user.UserService/LogIn" {
-			return handler(ctx, req)
-		}
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+		ctx, err := authenticateMethod(ctx, jwt, modes.mode(info.FullMethod))
+		if err != nil {
+			return nil, err
 		}
 
-		authorization, ok := md["authorization"]
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
-		}
+		return handler(ctx, req)
+	}
+}
 
-		token := strings.TrimPrefix(authorization[0], "Bearer ")
-		claims, err := jwt.ValidateToken(token)
+func StreamAuthInterceptor(jwt *auth.JWT, modes methodAuthModes) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateMethod(ss.Context(), jwt, modes.mode(info.FullMethod))
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "access denied: invalid token")
+			return err
 		}
 
-		userID, ok := claims["sub"].(string)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "access denied: invalid 'sub' field in token")
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream 은 인증된 사용자 ID 가 담긴 ctx 를 핸들러에 넘기기 위해 ServerStream 의 Context 를 바꾼다
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticateMethod(ctx context.Context, jwt *auth.JWT, mode authMode) (context.Context, error) {
+	switch mode {
+	case authPublic:
+		return ctx, nil
+	case authOptional:
+		if !hasAuthorization(ctx) {
+			return ctx, nil
 		}
+	}
 
-		ctx = context.WithValue(ctx, auth.UserIDKey, userID)
+	return authenticate(ctx, jwt)
+}
 
-		return handler(ctx, req)
+func hasAuthorization(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md["authorization"]) > 0
+}
+
+func authenticate(ctx context.Context, jwt *auth.JWT) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authorization, ok := md["authorization"]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	token := strings.TrimPrefix(authorization[0], "Bearer ")
+	claims, err := jwt.ValidateToken(token)
+	if err != nil {
+		return nil, err
 	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "access denied: invalid 'sub' field in token")
+	}
+
+	ctx = context.WithValue(ctx, auth.UserIDKey, userID)
+	if jti, ok := claims["jti"].(string); ok {
+		ctx = context.WithValue(ctx, auth.TokenIDKey, jti)
+	}
+	if sessionID, ok := claims["sid"].(string); ok {
+		ctx = context.WithValue(ctx, auth.SessionIDKey, sessionID)
+	}
+	if role, ok := claims["role"].(string); ok {
+		ctx = context.WithValue(ctx, auth.RoleKey, role)
+	}
+
+	return ctx, nil
 }
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPC 별로 호출에 필요한 최소 역할
+// 여기에 없는 RPC 는 인증만 통과하면 누구나 호출할 수 있고, 글 작성자 확인 같은 세부 권한은 핸들러가 검사한다
+var methodRoles = map[string]string{
+	"/v1.moderation.ModerationService/ListReports":   db.RoleModerator,
+	"/v1.moderation.ModerationService/ResolveReport": db.RoleModerator,
+	"/v1.moderation.ModerationService/HidePost":      db.RoleModerator,
+	"/v1.moderation.ModerationService/HideComment":   db.RoleModerator,
+	"/v1.moderation.ModerationService/SuspendUser":   db.RoleAdmin,
+	"/v1.moderation.ModerationService/SetUserRole":   db.RoleAdmin,
+}
+
+// AuthInterceptor 다음에 실행되어야 한다
+func PermissionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamPermissionInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, method string) error {
+	required, ok := methodRoles[method]
+	if !ok {
+		return nil
+	}
+
+	role, _ := ctx.Value(auth.RoleKey).(string)
+	if role == "" {
+		role = db.RoleUser
+	}
+
+	if !auth.HasRole(role, required) {
+		return status.Errorf(codes.PermissionDenied, "%s role is required", required)
+	}
+
+	return nil
+}
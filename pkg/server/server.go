@@ -5,10 +5,19 @@ import (
 	"net"
 
 	commentpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/comment"
+	mediapb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/media"
+	messagepb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/message"
+	moderationpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/moderation"
+	notificationpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/notification"
 	postpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/post"
 	userpb "github.com/YehyeokBang/Simple-SNS/pkg/api/v1/user"
 	"github.com/YehyeokBang/Simple-SNS/pkg/auth"
+	"github.com/YehyeokBang/Simple-SNS/pkg/export"
+	"github.com/YehyeokBang/Simple-SNS/pkg/mail"
+	"github.com/YehyeokBang/Simple-SNS/pkg/pubsub"
 	"github.com/YehyeokBang/Simple-SNS/pkg/server/handler"
+	"github.com/YehyeokBang/Simple-SNS/pkg/storage"
+	"github.com/YehyeokBang/Simple-SNS/pkg/timeline"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
@@ -18,14 +27,24 @@ const (
 )
 
 type Server struct {
-	DB  *gorm.DB
-	JWT *auth.JWT
+	DB       *gorm.DB
+	JWT      *auth.JWT
+	Timeline timeline.Strategy
+	Broker   pubsub.Broker
+	Mailer   mail.Mailer
+	Exporter *export.Exporter
+	Storage  storage.Storage
 }
 
-func NewServer(db *gorm.DB, jwt *auth.JWT) *Server {
+func NewServer(db *gorm.DB, jwt *auth.JWT, timeline timeline.Strategy, broker pubsub.Broker, mailer mail.Mailer, exporter *export.Exporter, storage storage.Storage) *Server {
 	return &Server{
-		DB:  db,
-		JWT: jwt,
+		DB:       db,
+		JWT:      jwt,
+		Timeline: timeline,
+		Broker:   broker,
+		Mailer:   mailer,
+		Exporter: exporter,
+		Storage:  storage,
 	}
 }
 
@@ -35,19 +54,33 @@ func (s *Server) MustRunGRPCServer() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	modes := loadMethodAuthModes()
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(AuthInterceptor(s.JWT)),
+		grpc.ChainUnaryInterceptor(AuthInterceptor(s.JWT, modes), PermissionInterceptor()),
+		grpc.ChainStreamInterceptor(StreamAuthInterceptor(s.JWT, modes), StreamPermissionInterceptor()),
 	)
 
-	userHandler := handler.NewUserHandler(s.DB, s.JWT)
+	userHandler := handler.NewUserHandler(s.DB, s.JWT, s.Timeline, s.Broker, s.Mailer, s.Exporter, s.Storage)
 	userpb.RegisterUserServiceServer(grpcServer, userHandler)
 
-	postHandler := handler.NewPostHandler(s.DB, s.JWT)
+	postHandler := handler.NewPostHandler(s.DB, s.JWT, s.Timeline, s.Broker)
 	postpb.RegisterPostServiceServer(grpcServer, postHandler)
 
-	commentHandler := handler.NewCommentHandler(s.DB, s.JWT)
+	commentHandler := handler.NewCommentHandler(s.DB, s.JWT, s.Broker)
 	commentpb.RegisterCommentServiceServer(grpcServer, commentHandler)
 
+	notificationHandler := handler.NewNotificationHandler(s.DB, s.JWT, s.Broker)
+	notificationpb.RegisterNotificationServiceServer(grpcServer, notificationHandler)
+
+	messageHandler := handler.NewMessageHandler(s.DB, s.JWT, s.Broker)
+	messagepb.RegisterMessageServiceServer(grpcServer, messageHandler)
+
+	moderationHandler := handler.NewModerationHandler(s.DB, s.JWT)
+	moderationpb.RegisterModerationServiceServer(grpcServer, moderationHandler)
+
+	mediaHandler := handler.NewMediaHandler(s.DB, s.JWT, s.Storage)
+	mediapb.RegisterMediaServiceServer(grpcServer, mediaHandler)
+
 	log.Printf("\n\n---------------------------------\n\n[grpc server is running on port %s]\n\n---------------------------------\n\n", port)
 
 	if err := grpcServer.Serve(listen); err != nil {
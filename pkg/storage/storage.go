@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/YehyeokBang/Simple-SNS/config"
+)
+
+var ErrNotFound = errors.New("storage: object is not exists")
+
+// Storage 는 업로드된 파일(blob)을 key 로 저장한다, key 는 "media/1/abc" 처럼 / 로 구분한다
+type Storage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// 없는 key 면 ErrNotFound 를 반환한다
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// S3_ENDPOINT 가 있으면 S3 호환 스토리지에, 없으면 MEDIA_DIR 디렉터리에 저장한다
+func MustNewStorage(cfg *config.Config) Storage {
+	if cfg.S3Endpoint != "" {
+		if cfg.S3Bucket == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+			log.Fatalf("environment variables S3_BUCKET, S3_ACCESS_KEY and S3_SECRET_KEY must be set when S3_ENDPOINT is set")
+		}
+
+		storage, err := NewS3Storage(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey)
+		if err != nil {
+			log.Fatalf("failed to create s3 storage: %v", err)
+		}
+		return storage
+	}
+
+	storage, err := NewLocalStorage(cfg.MediaDir)
+	if err != nil {
+		log.Fatalf("failed to create media directory: %v", err)
+	}
+	return storage
+}
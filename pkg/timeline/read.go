@@ -0,0 +1,32 @@
+package timeline
+
+import (
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FanOutOnRead 는 조회할 때마다 follows 를 조인해서 타임라인을 만든다
+type FanOutOnRead struct{}
+
+func (s *FanOutOnRead) Scope(userID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		following := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&db.Follow{}).
+			Select("following_id").
+			Where("follower_id = ?", userID)
+
+		return tx.Where("posts.user_id = ? OR posts.user_id IN (?)", userID, following)
+	}
+}
+
+func (s *FanOutOnRead) PostCreated(tx *gorm.DB, post *db.Post) error {
+	return nil
+}
+
+func (s *FanOutOnRead) Followed(tx *gorm.DB, followerID, followingID uint) error {
+	return nil
+}
+
+func (s *FanOutOnRead) Unfollowed(tx *gorm.DB, followerID, followingID uint) error {
+	return nil
+}
@@ -0,0 +1,35 @@
+package timeline
+
+import (
+	"log"
+
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+)
+
+const (
+	FanOutOnReadName  = "read"
+	FanOutOnWriteName = "write"
+)
+
+// Strategy 는 홈 타임라인을 구성하는 방식
+// 게시글 작성, 팔로우, 언팔로우는 같은 트랜잭션(tx) 안에서 호출된다
+type Strategy interface {
+	// 사용자의 홈 타임라인에 포함되는 게시글만 남기는 posts 조회 scope
+	Scope(userID uint) func(db *gorm.DB) *gorm.DB
+	PostCreated(tx *gorm.DB, post *db.Post) error
+	Followed(tx *gorm.DB, followerID, followingID uint) error
+	Unfollowed(tx *gorm.DB, followerID, followingID uint) error
+}
+
+func MustNewStrategy(name string) Strategy {
+	switch name {
+	case "", FanOutOnReadName:
+		return &FanOutOnRead{}
+	case FanOutOnWriteName:
+		return &FanOutOnWrite{}
+	default:
+		log.Fatalf("unknown timeline strategy: %s", name)
+		return nil
+	}
+}
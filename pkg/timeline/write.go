@@ -0,0 +1,71 @@
+package timeline
+
+import (
+	"github.com/YehyeokBang/Simple-SNS/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 팔로우 시점에 타임라인에 채워 넣는 상대방의 최근 게시글 수
+const backfillLimit = 100
+
+// FanOutOnWrite 는 게시글 작성 시 작성자와 팔로워들의 timeline_entries 에 미리 넣어둔다
+type FanOutOnWrite struct{}
+
+func (s *FanOutOnWrite) Scope(userID uint) func(db *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Joins("JOIN timeline_entries ON timeline_entries.post_id = posts.id").
+			Where("timeline_entries.user_id = ?", userID)
+	}
+}
+
+func (s *FanOutOnWrite) PostCreated(tx *gorm.DB, post *db.Post) error {
+	var followerIDs []uint
+	result := tx.Model(&db.Follow{}).
+		Where("following_id = ?", post.UserID).
+		Pluck("follower_id", &followerIDs)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	entries := []db.TimelineEntry{{UserID: post.UserID, PostID: post.ID}}
+	for _, followerID := range followerIDs {
+		entries = append(entries, db.TimelineEntry{UserID: followerID, PostID: post.ID})
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(&entries, 500).Error
+}
+
+func (s *FanOutOnWrite) Followed(tx *gorm.DB, followerID, followingID uint) error {
+	var postIDs []uint
+	result := tx.Model(&db.Post{}).
+		Where("user_id = ?", followingID).
+		Order("created_at desc").
+		Limit(backfillLimit).
+		Pluck("id", &postIDs)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	entries := make([]db.TimelineEntry, 0, len(postIDs))
+	for _, postID := range postIDs {
+		entries = append(entries, db.TimelineEntry{UserID: followerID, PostID: postID})
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+}
+
+func (s *FanOutOnWrite) Unfollowed(tx *gorm.DB, followerID, followingID uint) error {
+	posts := tx.Session(&gorm.Session{NewDB: true}).
+		Model(&db.Post{}).
+		Select("id").
+		Where("user_id = ?", followingID)
+
+	return tx.Where("user_id = ? AND post_id IN (?)", followerID, posts).
+		Delete(&db.TimelineEntry{}).Error
+}